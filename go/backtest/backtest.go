@@ -0,0 +1,449 @@
+// Package backtest runs event-driven trading strategies over the price
+// history of a *finalytics.Tickers, filling orders at the next bar's open
+// and tracking cash, positions and an equity curve bar by bar.
+package backtest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Nnamdi-sys/finalytics/go/finalytics"
+	"github.com/go-gota/gota/dataframe"
+)
+
+// Bar holds the OHLCV values for one symbol at one point in time.
+type Bar struct {
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// Position tracks the open quantity and average cost basis for one symbol.
+// Quantity is negative for short positions.
+type Position struct {
+	Symbol   string
+	Quantity float64
+	AvgCost  float64
+}
+
+// Fill records an order executed by the engine.
+type Fill struct {
+	Time       time.Time
+	Symbol     string
+	Side       string // "buy", "sell", "short", or "cover" (target-weight orders fill as "buy" or "sell")
+	Quantity   float64
+	Price      float64
+	Commission float64
+}
+
+// SlippageModel adjusts an intended fill price to account for market impact.
+type SlippageModel interface {
+	Adjust(side string, price float64) float64
+}
+
+// NoSlippage leaves fill prices unchanged.
+type NoSlippage struct{}
+
+// Adjust returns price unchanged.
+func (NoSlippage) Adjust(side string, price float64) float64 { return price }
+
+// PercentSlippage moves the fill price against the trader by a fixed
+// percentage: up on buy/cover, down on sell/short.
+type PercentSlippage struct {
+	Pct float64
+}
+
+// Adjust applies the configured percentage against the trader's side.
+func (s PercentSlippage) Adjust(side string, price float64) float64 {
+	switch side {
+	case "buy", "cover":
+		return price * (1 + s.Pct)
+	default:
+		return price * (1 - s.Pct)
+	}
+}
+
+// CommissionModel computes the commission charged for an order.
+type CommissionModel interface {
+	Commission(quantity, price float64) float64
+}
+
+// NoCommission charges nothing.
+type NoCommission struct{}
+
+// Commission returns 0.
+func (NoCommission) Commission(quantity, price float64) float64 { return 0 }
+
+// PerShareCommission charges a fixed amount per unit traded.
+type PerShareCommission struct {
+	PerShare float64
+}
+
+// Commission returns PerShare times the traded quantity.
+func (c PerShareCommission) Commission(quantity, price float64) float64 {
+	return math.Abs(quantity) * c.PerShare
+}
+
+// PercentCommission charges a fixed percentage of trade notional.
+type PercentCommission struct {
+	Pct float64
+}
+
+// Commission returns Pct times the trade's notional value.
+func (c PercentCommission) Commission(quantity, price float64) float64 {
+	return math.Abs(quantity) * price * c.Pct
+}
+
+// BarContext is passed to Strategy.OnBar once per timestamp in the backtest.
+// Orders placed on ctx are queued and filled at the next bar's open.
+type BarContext struct {
+	// Time is the timestamp of the current (just-closed) bar.
+	Time time.Time
+	// Bars holds the current bar for every symbol in the engine's Tickers.
+	Bars map[string]Bar
+
+	engine *Engine
+}
+
+// Buy queues a market order to increase the position in symbol by quantity.
+func (c *BarContext) Buy(symbol string, quantity float64) {
+	c.engine.queueOrder(symbol, "buy", quantity, nil)
+}
+
+// Sell queues a market order to reduce a long position in symbol by quantity.
+func (c *BarContext) Sell(symbol string, quantity float64) {
+	c.engine.queueOrder(symbol, "sell", quantity, nil)
+}
+
+// Short queues a market order to open or increase a short position in symbol by quantity.
+func (c *BarContext) Short(symbol string, quantity float64) {
+	c.engine.queueOrder(symbol, "short", quantity, nil)
+}
+
+// Cover queues a market order to reduce a short position in symbol by quantity.
+func (c *BarContext) Cover(symbol string, quantity float64) {
+	c.engine.queueOrder(symbol, "cover", quantity, nil)
+}
+
+// SetTargetWeights queues orders that rebalance the portfolio so each
+// symbol's market value is the given fraction of total equity. Symbols
+// omitted from weights are left untouched.
+func (c *BarContext) SetTargetWeights(weights map[string]float64) {
+	for symbol, w := range weights {
+		weight := w
+		c.engine.queueOrder(symbol, "target", 0, &weight)
+	}
+}
+
+// Cash returns the engine's uninvested cash balance as of the current bar.
+func (c *BarContext) Cash() float64 { return c.engine.cash }
+
+// Equity returns cash plus the mark-to-market value of all open positions
+// using the current bar's close prices.
+func (c *BarContext) Equity() float64 { return c.engine.equityAt(c.Bars) }
+
+// Position returns the engine's current position in symbol (zero-valued if flat).
+func (c *BarContext) Position(symbol string) Position {
+	if p, ok := c.engine.positions[symbol]; ok {
+		return *p
+	}
+	return Position{Symbol: symbol}
+}
+
+// Strategy is implemented by user-defined bar-by-bar backtest strategies.
+type Strategy interface {
+	// OnBar is invoked once per bar, in chronological order, after that
+	// bar's close is known. Orders placed on ctx fill at the next bar's open.
+	OnBar(ctx *BarContext)
+}
+
+// StrategyFunc adapts a plain function to the Strategy interface.
+type StrategyFunc func(ctx *BarContext)
+
+// OnBar calls f(ctx).
+func (f StrategyFunc) OnBar(ctx *BarContext) { f(ctx) }
+
+// pendingOrder is a queued order awaiting a fill at the next bar's open.
+type pendingOrder struct {
+	symbol       string
+	side         string
+	quantity     float64
+	targetWeight *float64
+}
+
+// Engine drives a Strategy bar-by-bar over a *finalytics.Tickers' price
+// history, filling queued orders at the next bar's open.
+type Engine struct {
+	// Tickers is the universe of symbols the engine simulates over. It must
+	// already be built with the desired symbols, interval and date range.
+	Tickers *finalytics.Tickers
+	// InitialCapital is the engine's starting cash balance.
+	InitialCapital float64
+	// Slippage adjusts fill prices; defaults to NoSlippage if nil.
+	Slippage SlippageModel
+	// Commission computes the commission charged per fill; defaults to NoCommission if nil.
+	Commission CommissionModel
+
+	cash      float64
+	positions map[string]*Position
+	pending   []pendingOrder
+	fills     []Fill
+	equity    []EquityPoint
+}
+
+// queueOrder appends a pending order, to be filled at the next bar's open.
+func (e *Engine) queueOrder(symbol, side string, quantity float64, targetWeight *float64) {
+	e.pending = append(e.pending, pendingOrder{symbol: symbol, side: side, quantity: quantity, targetWeight: targetWeight})
+}
+
+// equityAt returns cash plus the mark-to-market value of every open position
+// using bars' close prices.
+func (e *Engine) equityAt(bars map[string]Bar) float64 {
+	equity := e.cash
+	for symbol, pos := range e.positions {
+		if bar, ok := bars[symbol]; ok {
+			equity += pos.Quantity * bar.Close
+		}
+	}
+	return equity
+}
+
+// Result holds the outcome of running a Strategy over an Engine.
+type Result struct {
+	EquityCurve []EquityPoint
+	Fills       []Fill
+	Stats       Stats
+}
+
+// EquityPoint is one sample of a Result's equity curve.
+type EquityPoint struct {
+	Time   time.Time
+	Equity float64
+}
+
+// Run drives strategy bar-by-bar over e.Tickers' price history in
+// chronological order and returns the resulting fills, equity curve and
+// performance statistics.
+//
+// Parameters:
+//   - strategy: The Strategy to evaluate.
+//
+// Returns:
+//   - Result: The fills, equity curve and performance statistics produced by the run.
+//   - error: An error if the underlying price history cannot be retrieved or is empty.
+//
+// Example:
+//   engine := &backtest.Engine{Tickers: tickers, InitialCapital: 10000}
+//   result, err := engine.Run(backtest.StrategyFunc(func(ctx *backtest.BarContext) {
+//       ctx.SetTargetWeights(map[string]float64{"AAPL": 1.0})
+//   }))
+func (e *Engine) Run(strategy Strategy) (Result, error) {
+	history, err := e.Tickers.GetPriceHistory()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to get price history: %v", err)
+	}
+
+	barsBySymbol, times, err := barsFromHistory(history)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(times) == 0 {
+		return Result{}, fmt.Errorf("no price history available to backtest")
+	}
+
+	return e.runOverBars(strategy, barsBySymbol, times)
+}
+
+// runOverBars simulates strategy across the given per-symbol bars and their
+// shared, sorted timestamps. It underlies both Run, which derives bars from
+// e.Tickers' full history, and WalkForward, which derives them from a
+// restricted sub-range of times.
+func (e *Engine) runOverBars(strategy Strategy, barsBySymbol map[string][]Bar, times []time.Time) (Result, error) {
+	e.cash = e.InitialCapital
+	e.positions = make(map[string]*Position)
+	e.pending = nil
+	e.fills = nil
+	e.equity = nil
+	slippage := e.Slippage
+	if slippage == nil {
+		slippage = NoSlippage{}
+	}
+	commission := e.Commission
+	if commission == nil {
+		commission = NoCommission{}
+	}
+
+	for i, t := range times {
+		currentBars := make(map[string]Bar, len(barsBySymbol))
+		for symbol, bars := range barsBySymbol {
+			if i < len(bars) {
+				currentBars[symbol] = bars[i]
+			}
+		}
+
+		// Fill orders queued on the previous bar at this bar's open.
+		e.fillPending(t, currentBars, slippage, commission)
+
+		ctx := &BarContext{Time: t, Bars: currentBars, engine: e}
+		strategy.OnBar(ctx)
+
+		e.equity = append(e.equity, EquityPoint{Time: t, Equity: e.equityAt(currentBars)})
+	}
+
+	return Result{
+		EquityCurve: e.equity,
+		Fills:       e.fills,
+		Stats:       computeStats(e.equity, e.fills, e.InitialCapital),
+	}, nil
+}
+
+// fillPending executes every queued order at bars' open prices, using
+// slippage and commission, then clears the queue.
+func (e *Engine) fillPending(t time.Time, bars map[string]Bar, slippage SlippageModel, commission CommissionModel) {
+	for _, order := range e.pending {
+		bar, ok := bars[order.symbol]
+		if !ok {
+			continue
+		}
+
+		side := order.side
+		quantity := order.quantity
+		if order.targetWeight != nil {
+			side, quantity = e.targetWeightOrder(order.symbol, *order.targetWeight, bar, bars)
+			if quantity == 0 {
+				continue
+			}
+		}
+		if quantity == 0 {
+			continue
+		}
+
+		price := slippage.Adjust(side, bar.Open)
+		signedQty := quantity
+		if side == "sell" || side == "short" {
+			signedQty = -quantity
+		}
+
+		pos, ok := e.positions[order.symbol]
+		if !ok {
+			pos = &Position{Symbol: order.symbol}
+			e.positions[order.symbol] = pos
+		}
+		applyFill(pos, signedQty, price)
+
+		comm := commission.Commission(quantity, price)
+		e.cash -= signedQty*price + comm
+		e.fills = append(e.fills, Fill{Time: t, Symbol: order.symbol, Side: side, Quantity: quantity, Price: price, Commission: comm})
+	}
+	e.pending = nil
+}
+
+// targetWeightOrder converts a target portfolio weight into a concrete
+// buy/sell order sized to move the position's market value to weight * equity,
+// where equity values every open position at its own symbol's close in bars.
+func (e *Engine) targetWeightOrder(symbol string, weight float64, bar Bar, bars map[string]Bar) (side string, quantity float64) {
+	equity := e.equityAt(bars)
+	targetValue := weight * equity
+	currentValue := 0.0
+	if pos, ok := e.positions[symbol]; ok {
+		currentValue = pos.Quantity * bar.Close
+	}
+	delta := (targetValue - currentValue) / bar.Close
+	if delta > 0 {
+		return "buy", delta
+	} else if delta < 0 {
+		return "sell", -delta
+	}
+	return "", 0
+}
+
+// applyFill updates pos' quantity and average cost basis for a fill of
+// signedQty units at price (negative signedQty reduces or shorts the position).
+func applyFill(pos *Position, signedQty, price float64) {
+	newQuantity := pos.Quantity + signedQty
+	switch {
+	case pos.Quantity == 0 || sameSign(pos.Quantity, signedQty):
+		totalCost := pos.AvgCost*math.Abs(pos.Quantity) + price*math.Abs(signedQty)
+		pos.AvgCost = totalCost / math.Abs(newQuantity)
+	case math.Abs(signedQty) <= math.Abs(pos.Quantity):
+		// Reducing (or flattening) the position; average cost is unchanged.
+	default:
+		// Flipped from long to short or vice versa; the remainder establishes a new position.
+		pos.AvgCost = price
+	}
+	pos.Quantity = newQuantity
+}
+
+func sameSign(a, b float64) bool {
+	return (a > 0 && b > 0) || (a < 0 && b < 0)
+}
+
+// barsFromHistory groups a GetPriceHistory DataFrame's rows by symbol (when
+// a "symbol" column is present, as for multi-symbol Tickers) and returns each
+// symbol's bars along with the sorted, deduplicated set of timestamps across
+// all symbols.
+func barsFromHistory(history dataframe.DataFrame) (map[string][]Bar, []time.Time, error) {
+	timestamps := history.Col("timestamp").Records()
+	opens := history.Col("open").Float()
+	highs := history.Col("high").Float()
+	lows := history.Col("low").Float()
+	closes := history.Col("close").Float()
+	volumes := history.Col("volume").Float()
+
+	symbols := make([]string, len(timestamps))
+	hasSymbolColumn := contains(history.Names(), "symbol")
+	if hasSymbolColumn {
+		copy(symbols, history.Col("symbol").Records())
+	}
+
+	barsBySymbol := make(map[string][]Bar)
+	timeSet := make(map[time.Time]struct{})
+	var allTimes []time.Time
+
+	for i := range timestamps {
+		ts, err := parseTimestamp(timestamps[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse timestamp %q: %v", timestamps[i], err)
+		}
+		symbol := "default"
+		if hasSymbolColumn {
+			symbol = symbols[i]
+		}
+		bar := Bar{Time: ts, Open: opens[i], High: highs[i], Low: lows[i], Close: closes[i], Volume: volumes[i]}
+		barsBySymbol[symbol] = append(barsBySymbol[symbol], bar)
+		if _, seen := timeSet[ts]; !seen {
+			timeSet[ts] = struct{}{}
+			allTimes = append(allTimes, ts)
+		}
+	}
+	sort.Slice(allTimes, func(i, j int) bool { return allTimes[i].Before(allTimes[j]) })
+	for symbol := range barsBySymbol {
+		bars := barsBySymbol[symbol]
+		sort.Slice(bars, func(i, j int) bool { return bars[i].Time.Before(bars[j].Time) })
+		barsBySymbol[symbol] = bars
+	}
+	return barsBySymbol, allTimes, nil
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTimestamp(raw string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp format")
+}
@@ -0,0 +1,235 @@
+package backtest
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// Stats holds performance statistics derived from a Result's equity curve and fills.
+type Stats struct {
+	TotalReturn      float64 // (final equity / initial capital) - 1
+	Sharpe           float64 // annualized, using periodic equity-curve returns
+	Sortino          float64 // annualized, penalizing only downside deviation
+	MaxDrawdown      float64 // largest peak-to-trough decline in equity, as a positive fraction
+	ProfitFactor     float64 // gross profit / gross loss across closed trades (0 if there were no losses)
+	Expectancy       float64 // average PnL per closed trade
+	TotalTrades      int
+	WinRate          float64
+}
+
+// computeStats derives Stats from an equity curve and the fills that produced it.
+func computeStats(equity []EquityPoint, fills []Fill, initialCapital float64) Stats {
+	if len(equity) == 0 {
+		return Stats{}
+	}
+
+	returns := equityReturns(equity)
+	trades := tradePnLs(fills)
+
+	stats := Stats{
+		TotalReturn: (equity[len(equity)-1].Equity / initialCapital) - 1,
+		Sharpe:      sharpeRatio(returns),
+		Sortino:     sortinoRatio(returns),
+		MaxDrawdown: maxDrawdown(equity),
+	}
+	stats.ProfitFactor, stats.Expectancy, stats.TotalTrades, stats.WinRate = tradeSummary(trades)
+	return stats
+}
+
+// equityReturns computes simple period-over-period returns from an equity curve.
+func equityReturns(equity []EquityPoint) []float64 {
+	if len(equity) < 2 {
+		return nil
+	}
+	returns := make([]float64, 0, len(equity)-1)
+	for i := 1; i < len(equity); i++ {
+		prev := equity[i-1].Equity
+		if prev == 0 {
+			returns = append(returns, 0)
+			continue
+		}
+		returns = append(returns, (equity[i].Equity-prev)/prev)
+	}
+	return returns
+}
+
+func meanOf(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+func stdDevOf(xs []float64, mean float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	var sumSq float64
+	for _, x := range xs {
+		d := x - mean
+		sumSq += d * d
+	}
+	return math.Sqrt(sumSq / float64(len(xs)-1))
+}
+
+// annualizationFactor assumes daily bars; it is the usual default across the
+// package's other performance-ratio calculations.
+const annualizationFactor = 252
+
+func sharpeRatio(returns []float64) float64 {
+	mean := meanOf(returns)
+	std := stdDevOf(returns, mean)
+	if std == 0 {
+		return 0
+	}
+	return (mean / std) * math.Sqrt(annualizationFactor)
+}
+
+func sortinoRatio(returns []float64) float64 {
+	mean := meanOf(returns)
+	var downsideSq float64
+	var downsideCount int
+	for _, r := range returns {
+		if r < 0 {
+			downsideSq += r * r
+			downsideCount++
+		}
+	}
+	if downsideCount == 0 {
+		return 0
+	}
+	downsideDev := math.Sqrt(downsideSq / float64(downsideCount))
+	if downsideDev == 0 {
+		return 0
+	}
+	return (mean / downsideDev) * math.Sqrt(annualizationFactor)
+}
+
+func maxDrawdown(equity []EquityPoint) float64 {
+	peak := equity[0].Equity
+	maxDD := 0.0
+	for _, p := range equity {
+		if p.Equity > peak {
+			peak = p.Equity
+		}
+		if peak > 0 {
+			if dd := (peak - p.Equity) / peak; dd > maxDD {
+				maxDD = dd
+			}
+		}
+	}
+	return maxDD
+}
+
+// tradePnLs pairs opening and closing fills per symbol (FIFO) into realized
+// per-trade PnL. A position that is still open at the end of the run
+// contributes no trade.
+func tradePnLs(fills []Fill) []float64 {
+	type lot struct {
+		quantity float64
+		price    float64
+	}
+	open := make(map[string][]lot)
+	var pnls []float64
+
+	for _, f := range fills {
+		remaining := f.Quantity
+		if f.Side == "sell" || f.Side == "short" {
+			remaining = -remaining
+		}
+		lots := open[f.Symbol]
+
+		// Match against opening lots on the opposite side (FIFO), realizing
+		// PnL for each matched portion, until remaining is absorbed or no
+		// more opposite-side lots remain.
+		for remaining != 0 && len(lots) > 0 && !sameSign(lots[0].quantity, remaining) {
+			head := lots[0]
+			matched := math.Min(math.Abs(head.quantity), math.Abs(remaining))
+			direction := 1.0
+			if head.quantity < 0 {
+				direction = -1.0
+			}
+			grossPnL := direction * matched * (f.Price - head.price)
+			commissionShare := f.Commission * (matched / math.Abs(f.Quantity))
+			pnls = append(pnls, grossPnL-commissionShare)
+
+			if math.Abs(head.quantity) > matched {
+				lots[0].quantity -= direction * matched
+				remaining = 0
+			} else {
+				lots = lots[1:]
+				remaining += direction * matched
+			}
+		}
+		if remaining != 0 {
+			lots = append(lots, lot{quantity: remaining, price: f.Price})
+		}
+		open[f.Symbol] = lots
+	}
+	return pnls
+}
+
+// tradeSummary derives profit factor, expectancy, trade count and win rate from trade PnLs.
+func tradeSummary(pnls []float64) (profitFactor, expectancy float64, total int, winRate float64) {
+	total = len(pnls)
+	if total == 0 {
+		return 0, 0, 0, 0
+	}
+	var grossProfit, grossLoss, sum float64
+	var wins int
+	for _, pnl := range pnls {
+		sum += pnl
+		if pnl > 0 {
+			grossProfit += pnl
+			wins++
+		} else {
+			grossLoss += -pnl
+		}
+	}
+	expectancy = sum / float64(total)
+	winRate = float64(wins) / float64(total)
+	if grossLoss > 0 {
+		profitFactor = grossProfit / grossLoss
+	}
+	return profitFactor, expectancy, total, winRate
+}
+
+// Report builds a per-trade DataFrame (symbol, side, time, quantity, price,
+// commission) from r.Fills, suitable for exporting or rendering.
+//
+// Returns:
+//   - dataframe.DataFrame: One row per fill, in execution order.
+func (r Result) Report() dataframe.DataFrame {
+	n := len(r.Fills)
+	symbols := make([]string, n)
+	sides := make([]string, n)
+	times := make([]string, n)
+	quantities := make([]float64, n)
+	prices := make([]float64, n)
+	commissions := make([]float64, n)
+
+	for i, f := range r.Fills {
+		symbols[i] = f.Symbol
+		sides[i] = f.Side
+		times[i] = f.Time.Format(time.RFC3339)
+		quantities[i] = f.Quantity
+		prices[i] = f.Price
+		commissions[i] = f.Commission
+	}
+
+	return dataframe.New(
+		series.New(times, series.String, "time"),
+		series.New(symbols, series.String, "symbol"),
+		series.New(sides, series.String, "side"),
+		series.New(quantities, series.Float, "quantity"),
+		series.New(prices, series.Float, "price"),
+		series.New(commissions, series.Float, "commission"),
+	)
+}
@@ -0,0 +1,114 @@
+package backtest
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Nnamdi-sys/finalytics/go/finalytics"
+)
+
+// WalkForwardWindow is one train/test split produced by WalkForward, along
+// with the Result of running the strategy over its test window.
+type WalkForwardWindow struct {
+	TrainStart time.Time
+	TrainEnd   time.Time
+	TestStart  time.Time
+	TestEnd    time.Time
+	Result     Result
+}
+
+// WalkForward splits tickers' price history into successive train/test
+// windows of length train and test respectively, and runs the Strategy
+// built by strategyFor against each window's out-of-sample bars. strategyFor
+// is called once per window with its [trainStart, trainEnd) bounds so a
+// strategy can be (re)fit on the preceding train window before being
+// evaluated on the test window; callers that don't need fitting can ignore
+// its arguments and return the same Strategy every time.
+//
+// Parameters:
+//   - tickers: The Tickers whose price history is split into walk-forward windows.
+//   - train: The length of each in-sample (training) window.
+//   - test: The length of each out-of-sample (testing) window.
+//   - initialCapital: The starting cash for each test window's Engine.
+//   - strategyFor: Builds the Strategy to evaluate on a given [trainStart, trainEnd) window.
+//
+// Returns:
+//   - []WalkForwardWindow: One entry per completed train/test split, in chronological order.
+//   - error: An error if tickers' price history cannot be retrieved or a window's backtest failed.
+//
+// Example:
+//
+//	windows, err := backtest.WalkForward(tickers, 180*24*time.Hour, 30*24*time.Hour, 100000,
+//	    func(trainStart, trainEnd time.Time) backtest.Strategy {
+//	        return backtest.StrategyFunc(myStrategy)
+//	    })
+func WalkForward(
+	tickers *finalytics.Tickers,
+	train, test time.Duration,
+	initialCapital float64,
+	strategyFor func(trainStart, trainEnd time.Time) Strategy,
+) ([]WalkForwardWindow, error) {
+	history, err := tickers.GetPriceHistory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price history: %v", err)
+	}
+	barsBySymbol, times, err := barsFromHistory(history)
+	if err != nil {
+		return nil, err
+	}
+	if len(times) == 0 {
+		return nil, fmt.Errorf("no price history available to walk forward")
+	}
+
+	var windows []WalkForwardWindow
+	trainStart := times[0]
+	for {
+		trainEnd := trainStart.Add(train)
+		testStart := trainEnd
+		testEnd := testStart.Add(test)
+		if testEnd.After(times[len(times)-1]) {
+			break
+		}
+
+		startIdx := sort.Search(len(times), func(i int) bool { return !times[i].Before(testStart) })
+		endIdx := sort.Search(len(times), func(i int) bool { return !times[i].Before(testEnd) })
+		if startIdx < endIdx {
+			engine := &Engine{InitialCapital: initialCapital}
+			result, err := engine.runOverBars(strategyFor(trainStart, trainEnd), sliceBars(barsBySymbol, startIdx, endIdx), times[startIdx:endIdx])
+			if err != nil {
+				return windows, fmt.Errorf("backtest failed on window [%s, %s): %v",
+					testStart.Format("2006-01-02"), testEnd.Format("2006-01-02"), err)
+			}
+			windows = append(windows, WalkForwardWindow{
+				TrainStart: trainStart,
+				TrainEnd:   trainEnd,
+				TestStart:  testStart,
+				TestEnd:    testEnd,
+				Result:     result,
+			})
+		}
+
+		trainStart = trainStart.Add(test)
+	}
+
+	return windows, nil
+}
+
+// sliceBars restricts every symbol's bars to the [start, end) index range
+// used for a walk-forward test window, assuming (as barsFromHistory does)
+// that every symbol shares the same gap-free calendar.
+func sliceBars(barsBySymbol map[string][]Bar, start, end int) map[string][]Bar {
+	sliced := make(map[string][]Bar, len(barsBySymbol))
+	for symbol, bars := range barsBySymbol {
+		lo, hi := start, end
+		if hi > len(bars) {
+			hi = len(bars)
+		}
+		if lo > hi {
+			lo = hi
+		}
+		sliced[symbol] = bars[lo:hi]
+	}
+	return sliced
+}
@@ -0,0 +1,165 @@
+// Command finalyticsctl is a small CLI wrapper over the finalytics Go API,
+// useful for quick lookups from a terminal without writing a Go program.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Nnamdi-sys/finalytics/go/finalytics"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "quote":
+		err = runQuote(os.Args[2:])
+	case "history":
+		err = runHistory(os.Args[2:])
+	case "report":
+		err = runReport(os.Args[2:])
+	case "screen":
+		err = runScreen(os.Args[2:])
+	case "help", "-h", "--help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", os.Args[1])
+		usage()
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "finalyticsctl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `finalyticsctl is a CLI over the finalytics Go API.
+
+Usage:
+  finalyticsctl quote -symbol AAPL
+  finalyticsctl history -symbol AAPL -start 2023-01-01 -end 2023-12-31 -interval 1d
+  finalyticsctl report -symbol AAPL -type performance
+  finalyticsctl screen -type EQUITY -field intradaymarketcap -gte 10e9 -sort intradaymarketcap -size 10`)
+}
+
+func runQuote(args []string) error {
+	fs := flag.NewFlagSet("quote", flag.ExitOnError)
+	symbol := fs.String("symbol", "", "ticker symbol (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *symbol == "" {
+		return fmt.Errorf("-symbol is required")
+	}
+
+	ticker, err := finalytics.NewTickerBuilder().Symbol(*symbol).Build()
+	if err != nil {
+		return fmt.Errorf("failed to create ticker: %w", err)
+	}
+	defer ticker.Free()
+
+	quote, err := ticker.GetQuote()
+	if err != nil {
+		return fmt.Errorf("failed to get quote: %w", err)
+	}
+	fmt.Printf("%v\n", quote)
+	return nil
+}
+
+func runHistory(args []string) error {
+	fs := flag.NewFlagSet("history", flag.ExitOnError)
+	symbol := fs.String("symbol", "", "ticker symbol (required)")
+	start := fs.String("start", "", "start date (YYYY-MM-DD)")
+	end := fs.String("end", "", "end date (YYYY-MM-DD)")
+	interval := fs.String("interval", "1d", "data interval")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *symbol == "" {
+		return fmt.Errorf("-symbol is required")
+	}
+
+	ticker, err := finalytics.NewTickerBuilder().
+		Symbol(*symbol).
+		StartDate(*start).
+		EndDate(*end).
+		Interval(*interval).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create ticker: %w", err)
+	}
+	defer ticker.Free()
+
+	history, err := ticker.GetPriceHistory()
+	if err != nil {
+		return fmt.Errorf("failed to get price history: %w", err)
+	}
+	fmt.Println(history)
+	return nil
+}
+
+func runReport(args []string) error {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	symbol := fs.String("symbol", "", "ticker symbol (required)")
+	reportType := fs.String("type", "performance", "report type (performance, financials, options, news)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *symbol == "" {
+		return fmt.Errorf("-symbol is required")
+	}
+
+	ticker, err := finalytics.NewTickerBuilder().Symbol(*symbol).Build()
+	if err != nil {
+		return fmt.Errorf("failed to create ticker: %w", err)
+	}
+	defer ticker.Free()
+
+	report, err := ticker.Report(*reportType)
+	if err != nil {
+		return fmt.Errorf("failed to get report: %w", err)
+	}
+	return report.Show()
+}
+
+func runScreen(args []string) error {
+	fs := flag.NewFlagSet("screen", flag.ExitOnError)
+	quoteType := fs.String("type", "EQUITY", "quote type (EQUITY, MUTUALFUND, ETF, INDEX, FUTURE, CRYPTOCURRENCY)")
+	field := fs.String("field", "", "metric to filter on")
+	gte := fs.Float64("gte", 0, "greater-than-or-equal threshold for -field")
+	sort := fs.String("sort", "", "metric to sort by")
+	size := fs.Uint("size", 10, "maximum number of results")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	builder := finalytics.NewScreenerBuilder(*quoteType)
+	if *field != "" {
+		builder = builder.Where(*field).Gte(*gte)
+	}
+	if *sort != "" {
+		builder = builder.SortBy(*sort, true)
+	}
+	screener, err := builder.Page(0, *size).Build()
+	if err != nil {
+		return fmt.Errorf("failed to build screener: %w", err)
+	}
+	defer screener.Free()
+
+	symbols, err := screener.Symbols()
+	if err != nil {
+		return fmt.Errorf("failed to get symbols: %w", err)
+	}
+	fmt.Println(strings.Join(symbols, ", "))
+	return nil
+}
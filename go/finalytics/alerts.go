@@ -0,0 +1,199 @@
+package finalytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Alert describes a single condition match produced by an AlertManager.
+type Alert struct {
+	Symbol  string
+	Message string
+	Quote   map[string]any
+}
+
+// Condition evaluates a Ticker's latest quote and reports whether it has been
+// triggered, along with a human-readable message describing the match.
+type Condition interface {
+	Evaluate(quote map[string]any) (triggered bool, message string)
+}
+
+// ConditionFunc adapts a plain function to the Condition interface.
+type ConditionFunc func(quote map[string]any) (bool, string)
+
+// Evaluate calls f(quote).
+func (f ConditionFunc) Evaluate(quote map[string]any) (bool, string) {
+	return f(quote)
+}
+
+// PriceAbove returns a Condition that triggers when the quote's regularMarketPrice
+// rises above threshold.
+func PriceAbove(threshold float64) Condition {
+	return ConditionFunc(func(quote map[string]any) (bool, string) {
+		price, ok := quote["regularMarketPrice"].(float64)
+		if !ok || price <= threshold {
+			return false, ""
+		}
+		return true, fmt.Sprintf("price %.2f is above threshold %.2f", price, threshold)
+	})
+}
+
+// PriceBelow returns a Condition that triggers when the quote's regularMarketPrice
+// falls below threshold.
+func PriceBelow(threshold float64) Condition {
+	return ConditionFunc(func(quote map[string]any) (bool, string) {
+		price, ok := quote["regularMarketPrice"].(float64)
+		if !ok || price >= threshold {
+			return false, ""
+		}
+		return true, fmt.Sprintf("price %.2f is below threshold %.2f", price, threshold)
+	})
+}
+
+// Notifier delivers an Alert to some external destination.
+type Notifier interface {
+	Notify(alert Alert) error
+}
+
+// ConsoleNotifier prints alerts to stdout. Useful as a default/debug Notifier.
+type ConsoleNotifier struct{}
+
+// Notify prints the alert to stdout.
+func (ConsoleNotifier) Notify(alert Alert) error {
+	fmt.Printf("[ALERT] %s: %s\n", alert.Symbol, alert.Message)
+	return nil
+}
+
+// WebhookNotifier POSTs the alert as JSON to a configured URL.
+type WebhookNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+// Notify POSTs the alert as a JSON body to w.URL.
+func (w WebhookNotifier) Notify(alert Alert) error {
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert: %v", err)
+	}
+	resp, err := client.Post(w.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to deliver webhook: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// ChannelNotifier delivers alerts onto a Go channel for in-process consumption.
+type ChannelNotifier struct {
+	Ch chan<- Alert
+}
+
+// Notify sends the alert on c.Ch.
+func (c ChannelNotifier) Notify(alert Alert) error {
+	c.Ch <- alert
+	return nil
+}
+
+// AlertManager periodically polls a Ticker's quote and dispatches an Alert to
+// every registered Notifier whenever a registered Condition triggers.
+type AlertManager struct {
+	ticker     *Ticker
+	interval   time.Duration
+	conditions []Condition
+	notifiers  []Notifier
+}
+
+// NewAlertManager creates an AlertManager that polls ticker's quote every interval.
+//
+// Parameters:
+//   - ticker: The Ticker to monitor.
+//   - interval: The polling interval between quote checks.
+//
+// Returns:
+//   - *AlertManager: A pointer to the initialized AlertManager.
+//
+// Example:
+//   manager := finalytics.NewAlertManager(ticker, time.Minute)
+func NewAlertManager(ticker *Ticker, interval time.Duration) *AlertManager {
+	return &AlertManager{ticker: ticker, interval: interval}
+}
+
+// AddCondition registers a Condition to evaluate on every poll.
+//
+// Returns:
+//   - *AlertManager: The manager instance for method chaining.
+func (m *AlertManager) AddCondition(c Condition) *AlertManager {
+	m.conditions = append(m.conditions, c)
+	return m
+}
+
+// AddNotifier registers a Notifier to receive triggered Alerts.
+//
+// Returns:
+//   - *AlertManager: The manager instance for method chaining.
+func (m *AlertManager) AddNotifier(n Notifier) *AlertManager {
+	m.notifiers = append(m.notifiers, n)
+	return m
+}
+
+// Run polls the ticker's quote every m.interval until ctx is cancelled,
+// dispatching an Alert to every Notifier for each Condition that triggers.
+// It blocks until ctx is done; callers typically run it in its own goroutine.
+//
+// Returns:
+//   - error: The first error encountered fetching a quote or delivering an alert
+//     to a Notifier, or ctx.Err() on cancellation.
+//
+// Example:
+//   ctx, cancel := context.WithCancel(context.Background())
+//   defer cancel()
+//   go manager.Run(ctx)
+func (m *AlertManager) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := m.poll(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *AlertManager) poll() error {
+	quote, err := m.ticker.GetQuote()
+	if err != nil {
+		return fmt.Errorf("failed to get quote: %v", err)
+	}
+	symbol, _ := quote["symbol"].(string)
+
+	for _, cond := range m.conditions {
+		triggered, message := cond.Evaluate(quote)
+		if !triggered {
+			continue
+		}
+		alert := Alert{Symbol: symbol, Message: message, Quote: quote}
+		for _, notifier := range m.notifiers {
+			if err := notifier.Notify(alert); err != nil {
+				return fmt.Errorf("failed to notify %T: %v", notifier, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package finalytics
+
+/*
+#include <finalytics.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"bytes"
+	"fmt"
+	"unsafe"
+
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// parseArrowToDataFrame decodes an Arrow IPC stream (as produced by the Rust
+// side via finalytics_*_arrow calls) directly into a DataFrame, skipping the
+// JSON marshal/unmarshal round-trip that parseJSONToDataFrame requires.
+func parseArrowToDataFrame(data []byte) (dataframe.DataFrame, error) {
+	reader, err := ipc.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return dataframe.DataFrame{}, fmt.Errorf("failed to open Arrow IPC stream: %v", err)
+	}
+	defer reader.Release()
+
+	var cols []series.Series
+	var names []string
+	for reader.Next() {
+		rec := reader.Record()
+		schema := rec.Schema()
+		if names == nil {
+			names = make([]string, schema.NumFields())
+			for i, f := range schema.Fields() {
+				names[i] = f.Name
+			}
+			cols = make([]series.Series, schema.NumFields())
+		}
+		for i := 0; i < int(rec.NumCols()); i++ {
+			s, err := arrowColumnToSeries(names[i], rec.Column(i))
+			if err != nil {
+				return dataframe.DataFrame{}, err
+			}
+			if cols[i].Len() == 0 {
+				cols[i] = s
+			} else {
+				cols[i] = cols[i].Concat(s)
+			}
+		}
+	}
+	if err := reader.Err(); err != nil {
+		return dataframe.DataFrame{}, fmt.Errorf("failed to read Arrow IPC stream: %v", err)
+	}
+	return dataframe.New(cols...), nil
+}
+
+// arrowColumnToSeries converts a single Arrow array column into a gota series,
+// supporting the float64/int64/string/bool types that the Rust FFI emits.
+func arrowColumnToSeries(name string, col interface{ String() string }) (series.Series, error) {
+	switch arr := col.(type) {
+	case *array.Float64:
+		vals := make([]float64, arr.Len())
+		for i := range vals {
+			vals[i] = arr.Value(i)
+		}
+		return series.New(vals, series.Float, name), nil
+	case *array.Int64:
+		vals := make([]int, arr.Len())
+		for i := range vals {
+			vals[i] = int(arr.Value(i))
+		}
+		return series.New(vals, series.Int, name), nil
+	case *array.Boolean:
+		vals := make([]bool, arr.Len())
+		for i := range vals {
+			vals[i] = arr.Value(i)
+		}
+		return series.New(vals, series.Bool, name), nil
+	case *array.String:
+		vals := make([]string, arr.Len())
+		for i := range vals {
+			vals[i] = arr.Value(i)
+		}
+		return series.New(vals, series.String, name), nil
+	default:
+		return series.Series{}, fmt.Errorf("unsupported Arrow column type for %q: %T", name, col)
+	}
+}
+
+// parseArrowBytesResult reads the Arrow IPC byte buffer written by the FFI
+// layer at cOutput/cLen into a DataFrame and frees the underlying C buffer.
+func parseArrowBytesResult(cOutput *C.char, cLen C.long) (dataframe.DataFrame, error) {
+	defer C.finalytics_free_bytes(cOutput, cLen)
+	if cOutput == nil || cLen == 0 {
+		return dataframe.DataFrame{}, fmt.Errorf("failed to get result")
+	}
+	data := C.GoBytes(unsafe.Pointer(cOutput), C.int(cLen))
+	return parseArrowToDataFrame(data)
+}
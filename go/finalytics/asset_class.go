@@ -0,0 +1,54 @@
+package finalytics
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AssetClass identifies the kind of financial instrument a Ticker/Tickers
+// symbol refers to, mirroring the quoteType values accepted by NewScreener.
+type AssetClass string
+
+const (
+	// EquityAssetClass identifies common stocks (e.g. "AAPL").
+	EquityAssetClass AssetClass = "EQUITY"
+	// ETFAssetClass identifies exchange-traded funds (e.g. "SPY").
+	ETFAssetClass AssetClass = "ETF"
+	// MutualFundAssetClass identifies mutual funds, conventionally five-letter
+	// symbols ending in "X" (e.g. "VTSAX").
+	MutualFundAssetClass AssetClass = "MUTUALFUND"
+	// IndexAssetClass identifies market indices, conventionally prefixed with
+	// "^" on Yahoo Finance (e.g. "^GSPC").
+	IndexAssetClass AssetClass = "INDEX"
+	// CryptoAssetClass identifies cryptocurrencies (e.g. "BTC-USD").
+	CryptoAssetClass AssetClass = "CRYPTOCURRENCY"
+	// FutureAssetClass identifies futures contracts (e.g. "ES=F").
+	FutureAssetClass AssetClass = "FUTURE"
+	// ForexAssetClass identifies currency pairs (e.g. "EURUSD=X").
+	ForexAssetClass AssetClass = "FOREX"
+	// OptionAssetClass identifies options contracts (e.g. "AAPL240119C00150000").
+	OptionAssetClass AssetClass = "OPTION"
+)
+
+// validateSymbolsForAssetClass checks that symbols follow the naming
+// convention expected of assetClass, catching an obviously mismatched asset
+// class (e.g. requesting IndexAssetClass for "AAPL") before any FFI call.
+func validateSymbolsForAssetClass(assetClass AssetClass, symbols []string) error {
+	for _, symbol := range symbols {
+		switch assetClass {
+		case IndexAssetClass:
+			if !strings.HasPrefix(symbol, "^") {
+				return fmt.Errorf("symbol %q does not look like an index (expected a \"^\" prefix) for asset class %s", symbol, assetClass)
+			}
+		case MutualFundAssetClass:
+			if len(symbol) != 5 || !strings.HasSuffix(symbol, "X") {
+				return fmt.Errorf("symbol %q does not look like a mutual fund (expected a 5-letter symbol ending in \"X\") for asset class %s", symbol, assetClass)
+			}
+		case ForexAssetClass:
+			if !strings.HasSuffix(symbol, "=X") {
+				return fmt.Errorf("symbol %q does not look like a forex pair (expected a \"=X\" suffix) for asset class %s", symbol, assetClass)
+			}
+		}
+	}
+	return nil
+}
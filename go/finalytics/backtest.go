@@ -0,0 +1,108 @@
+package finalytics
+
+import (
+	"fmt"
+
+	"github.com/go-gota/gota/dataframe"
+)
+
+// Signal represents the desired position a Strategy wants to hold after a bar closes.
+type Signal int
+
+const (
+	// Hold keeps the current position unchanged.
+	Hold Signal = iota
+	// Buy moves the position fully into the asset.
+	Buy
+	// Sell moves the position fully into cash.
+	Sell
+)
+
+// BacktestContext is passed to a Strategy on every bar, giving it access to the
+// full price history and its current position in it.
+type BacktestContext struct {
+	History dataframe.DataFrame
+	Index   int
+}
+
+// Strategy decides, bar by bar, whether to be long or flat a Ticker.
+// Implementations should be side-effect free with respect to ctx.History.
+type Strategy interface {
+	// OnBar is called once per historical bar from Index 1 onward (after bar
+	// Index has closed) and returns the desired position signal for the next
+	// bar. It is not called for the first bar, Index 0.
+	OnBar(ctx *BacktestContext) Signal
+}
+
+// StrategyFunc adapts a plain function to the Strategy interface.
+type StrategyFunc func(ctx *BacktestContext) Signal
+
+// OnBar calls f(ctx).
+func (f StrategyFunc) OnBar(ctx *BacktestContext) Signal {
+	return f(ctx)
+}
+
+// BacktestResult holds the outcome of running a Strategy over a Ticker's price history.
+type BacktestResult struct {
+	EquityCurve []float64  // portfolio value at the close of each bar, starting at the initial capital
+	TotalReturn float64    // (final equity / initial capital) - 1
+	TradeStats  TradeStats // trade-level statistics derived from the equity curve's returns
+}
+
+// Backtest runs strategy bar-by-bar over the ticker's close price history,
+// starting with initialCapital, moving fully into or out of the asset on Buy/Sell
+// signals and holding the current position on Hold. It is a single-asset,
+// no-fees, no-slippage simulation intended for quick strategy iteration.
+//
+// Parameters:
+//   - strategy: The Strategy to evaluate.
+//   - initialCapital: The starting portfolio value.
+//
+// Returns:
+//   - BacktestResult: The resulting equity curve and performance statistics.
+//   - error: An error if the price history retrieval fails.
+//
+// Example:
+//   sma := finalytics.StrategyFunc(func(ctx *finalytics.BacktestContext) finalytics.Signal {
+//       if ctx.Index < 20 {
+//           return finalytics.Hold
+//       }
+//       return finalytics.Buy
+//   })
+//   result, err := ticker.Backtest(sma, 10000)
+func (t *Ticker) Backtest(strategy Strategy, initialCapital float64) (BacktestResult, error) {
+	history, err := t.GetPriceHistory()
+	if err != nil {
+		return BacktestResult{}, fmt.Errorf("failed to get price history: %v", err)
+	}
+	closes := history.Col("close").Float()
+	if len(closes) == 0 {
+		return BacktestResult{}, fmt.Errorf("no price history available to backtest")
+	}
+
+	equity := make([]float64, len(closes))
+	equity[0] = initialCapital
+	inPosition := false
+
+	for i := 1; i < len(closes); i++ {
+		if inPosition {
+			equity[i] = equity[i-1] * (closes[i] / closes[i-1])
+		} else {
+			equity[i] = equity[i-1]
+		}
+
+		switch strategy.OnBar(&BacktestContext{History: history, Index: i}) {
+		case Buy:
+			inPosition = true
+		case Sell:
+			inPosition = false
+		}
+	}
+
+	result := BacktestResult{
+		EquityCurve: equity,
+		TotalReturn: (equity[len(equity)-1] / initialCapital) - 1,
+		TradeStats:  computeTradeStats(returnsFromCloses(equity)),
+	}
+	return result, nil
+}
@@ -0,0 +1,188 @@
+package finalytics
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-gota/gota/dataframe"
+)
+
+// cacheEndpointTTLs overrides a diskCache's default TTL for endpoints whose
+// staleness tolerance differs from price history, e.g. fundamentals change
+// at most quarterly while the options chain moves within minutes.
+var cacheEndpointTTLs = map[string]time.Duration{
+	"income_statement":   24 * time.Hour,
+	"balance_sheet":      24 * time.Hour,
+	"cashflow_statement": 24 * time.Hour,
+	"financial_ratios":   24 * time.Hour,
+	"options_chain":      5 * time.Minute,
+}
+
+// FileCache is the on-disk cache backing TickerBuilder.Cache and
+// TickersBuilder.Cache. Entries are persisted as JSON snapshots rather than
+// literal CSV/Parquet files, reusing the same TTL and invalidation machinery
+// for both the single-symbol and multi-symbol builders.
+type FileCache = diskCache
+
+// diskCache persists FFI dataframe responses as JSON snapshots under dir,
+// keyed by (source, symbols, endpoint, interval, startDate, endDate). It
+// backs TickerBuilder.Cache and TickersBuilder.Cache.
+type diskCache struct {
+	dir string
+	ttl time.Duration
+	mu  sync.Mutex
+}
+
+// newDiskCache returns a diskCache rooted at dir using ttl as the default
+// entry lifetime for endpoints without a more specific override.
+func newDiskCache(dir string, ttl time.Duration) *diskCache {
+	return &diskCache{dir: dir, ttl: ttl}
+}
+
+func (c *diskCache) ttlFor(endpoint string) time.Duration {
+	if ttl, ok := cacheEndpointTTLs[endpoint]; ok {
+		return ttl
+	}
+	return c.ttl
+}
+
+type cacheEnvelope struct {
+	CachedAt time.Time       `json:"cachedAt"`
+	Rows     json.RawMessage `json:"rows"`
+}
+
+// cacheKey derives a filename-safe key identifying a cached response.
+func cacheKey(source, symbols, endpoint, interval, startDate, endDate string) string {
+	h := sha256.Sum256([]byte(strings.Join([]string{source, symbols, endpoint, interval, startDate, endDate}, "|")))
+	return hex.EncodeToString(h[:])
+}
+
+func (c *diskCache) entryPath(endpoint, key string) string {
+	return filepath.Join(c.dir, fmt.Sprintf("%s_%s.json", endpoint, key))
+}
+
+// get returns the cached DataFrame for (endpoint, key) if present and not
+// past its TTL.
+func (c *diskCache) get(endpoint, key string) (dataframe.DataFrame, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	raw, err := os.ReadFile(c.entryPath(endpoint, key))
+	if err != nil {
+		return dataframe.DataFrame{}, false
+	}
+	var env cacheEnvelope
+	if err := json.Unmarshal(raw, &env); err != nil {
+		return dataframe.DataFrame{}, false
+	}
+	if time.Since(env.CachedAt) > c.ttlFor(endpoint) {
+		return dataframe.DataFrame{}, false
+	}
+	df := dataframe.ReadJSON(bytes.NewReader(env.Rows))
+	if df.Err != nil {
+		return dataframe.DataFrame{}, false
+	}
+	return df, true
+}
+
+// put persists df under (endpoint, key), overwriting any existing entry.
+func (c *diskCache) put(endpoint, key string, df dataframe.DataFrame) error {
+	rows, err := json.Marshal(df.Maps())
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry: %v", err)
+	}
+	raw, err := json.Marshal(cacheEnvelope{CachedAt: time.Now(), Rows: rows})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache envelope: %v", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create cache dir: %v", err)
+	}
+	return os.WriteFile(c.entryPath(endpoint, key), raw, 0o644)
+}
+
+// invalidate removes every cached entry for endpoint.
+func (c *diskCache) invalidate(endpoint string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	matches, err := filepath.Glob(filepath.Join(c.dir, endpoint+"_*.json"))
+	if err != nil {
+		return fmt.Errorf("failed to list cache entries: %v", err)
+	}
+	for _, path := range matches {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove cache entry %s: %v", path, err)
+		}
+	}
+	return nil
+}
+
+// purge removes every cached entry under dir.
+func (c *diskCache) purge() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	matches, err := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	if err != nil {
+		return fmt.Errorf("failed to list cache entries: %v", err)
+	}
+	for _, path := range matches {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove cache entry %s: %v", path, err)
+		}
+	}
+	return nil
+}
+
+// registeredCaches tracks every diskCache created via TickerBuilder.Cache or
+// TickersBuilder.Cache so
+// PurgeCache can clear them all regardless of which Tickers created them.
+var (
+	registeredCachesMu sync.Mutex
+	registeredCaches   []*diskCache
+)
+
+func registerCache(c *diskCache) {
+	registeredCachesMu.Lock()
+	defer registeredCachesMu.Unlock()
+	for _, existing := range registeredCaches {
+		if existing.dir == c.dir {
+			return
+		}
+	}
+	registeredCaches = append(registeredCaches, c)
+}
+
+// PurgeCache deletes every entry in every on-disk cache created via
+// TickerBuilder.Cache or TickersBuilder.Cache across the process, regardless
+// of which Ticker or Tickers created them.
+//
+// Returns:
+//   - error: The first error encountered while purging, if any.
+//
+// Example:
+//   err := finalytics.PurgeCache()
+func PurgeCache() error {
+	registeredCachesMu.Lock()
+	caches := append([]*diskCache(nil), registeredCaches...)
+	registeredCachesMu.Unlock()
+
+	for _, c := range caches {
+		if err := c.purge(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package finalytics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ChartTheme selects a chart's color scheme.
+type ChartTheme string
+
+const (
+	// LightChartTheme is Plotly's default "plotly_white" template.
+	LightChartTheme ChartTheme = "light"
+	// DarkChartTheme switches the chart to Plotly's "plotly_dark" template.
+	DarkChartTheme ChartTheme = "dark"
+)
+
+// AnnotationRange highlights a date/time span on a chart's x-axis, e.g. to
+// mark a drawdown period or an earnings date range.
+type AnnotationRange struct {
+	// Start and End bound the range, in whatever format the chart's x-axis
+	// already uses (a date string for daily+ intervals, RFC3339 for intraday).
+	Start, End string
+	Label      string
+}
+
+// ChartOptions customizes a chart's appearance beyond height/width: theme,
+// axis scale, a crosshair-style unified hover, and highlighted date ranges.
+// It is accepted as a trailing variadic argument by the chart methods in
+// this package (Ticker.PerformanceChart, Ticker.CandlestickChart,
+// Ticker.OptionsChart, Ticker.NewsSentimentChart, Portfolio.PerformanceChart);
+// only the first one passed is used. Passing none keeps the chart's default
+// appearance.
+//
+// Overlays names indicators to draw on top of the chart (e.g. "sma20",
+// "ema50", "bb20"); see Ticker.WithIndicators for how they're computed.
+type ChartOptions struct {
+	Theme            ChartTheme
+	LogarithmicYAxis bool
+	Crosshair        bool
+	AnnotationRanges []AnnotationRange
+	Overlays         []string
+}
+
+// firstChartOptions returns opts[0] if non-empty, otherwise the zero value.
+func firstChartOptions(opts []ChartOptions) ChartOptions {
+	if len(opts) == 0 {
+		return ChartOptions{}
+	}
+	return opts[0]
+}
+
+// applyChartOptions appends a Plotly.relayout call reflecting opts to html,
+// just before </body>. It is a no-op (returns html unchanged) if opts asks
+// for nothing beyond the chart's existing defaults.
+func applyChartOptions(html string, opts ...ChartOptions) string {
+	o := firstChartOptions(opts)
+	script := chartOptionsScript(o)
+	if script == "" {
+		return html
+	}
+	if strings.Contains(html, "</body>") {
+		return strings.Replace(html, "</body>", script+"</body>", 1)
+	}
+	return html + script
+}
+
+// chartOptionsScript builds the <script> tag applying o to the chart's
+// Plotly figure via Plotly.relayout, or "" if o doesn't change anything.
+func chartOptionsScript(o ChartOptions) string {
+	layout := map[string]any{}
+
+	switch o.Theme {
+	case DarkChartTheme:
+		layout["template"] = "plotly_dark"
+	case LightChartTheme:
+		layout["template"] = "plotly_white"
+	}
+	if o.LogarithmicYAxis {
+		layout["yaxis.type"] = "log"
+	}
+	if o.Crosshair {
+		layout["hovermode"] = "x unified"
+	}
+	if len(o.AnnotationRanges) > 0 {
+		shapes := make([]map[string]any, len(o.AnnotationRanges))
+		for i, r := range o.AnnotationRanges {
+			shapes[i] = map[string]any{
+				"type":      "rect",
+				"xref":      "x",
+				"yref":      "paper",
+				"x0":        r.Start,
+				"x1":        r.End,
+				"y0":        0,
+				"y1":        1,
+				"fillcolor": "rgba(255, 165, 0, 0.15)",
+				"line":      map[string]any{"width": 0},
+				"label":     map[string]any{"text": r.Label},
+			}
+		}
+		layout["shapes"] = shapes
+	}
+
+	if len(layout) == 0 {
+		return ""
+	}
+	payload, err := json.Marshal(layout)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf(`
+<script>
+(function() {
+    var gd = document.querySelector(".plotly-graph-div");
+    if (gd && typeof Plotly !== "undefined") {
+        Plotly.relayout(gd, %s);
+    }
+})();
+</script>
+`, payload)
+}
@@ -0,0 +1,268 @@
+package finalytics
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AssetConstraint bounds the weight the optimizer may assign to a single
+// asset, identified by its ticker symbol.
+type AssetConstraint struct {
+	Symbol    string
+	MinWeight float64
+	MaxWeight float64
+}
+
+// CategoryConstraint bounds the combined weight the optimizer may assign to
+// a set of assets sharing a label, such as a sector, region, or asset class.
+// A symbol may belong to at most one CategoryConstraint per
+// OptimizeWithOptions call.
+type CategoryConstraint struct {
+	Category  string
+	Members   []string
+	MinWeight float64
+	MaxWeight float64
+}
+
+// GroupConstraint bounds the combined weight of an arbitrary, possibly
+// overlapping, subset of assets (e.g. "all crypto plus the tech ETF"),
+// unlike CategoryConstraint which partitions symbols into mutually exclusive
+// buckets.
+type GroupConstraint struct {
+	Members   []string
+	MinWeight float64
+	MaxWeight float64
+}
+
+// TurnoverConstraint bounds the L1 distance (the sum of absolute weight
+// changes) between the optimized portfolio and a supplied set of initial
+// weights.
+type TurnoverConstraint struct {
+	InitialWeights map[string]float64
+	MaxTurnover    float64
+}
+
+// LookThroughSectorConstraint bounds a portfolio's combined exposure to
+// Sector once ETF and mutual fund symbols are expanded into their underlying
+// holdings (via Tickers.FundSectorBreakdown), rather than treating a fund
+// symbol as belonging wholly to one category the way CategoryConstraint
+// does. Unlike the other constraint types, the optimizer's categorical
+// constraint machinery cannot consume fractional, per-holding membership, so
+// this is enforced as a post-optimization check: OptimizeWithOptions returns
+// an error if the optimized weights violate it rather than steering the
+// optimizer away from violating it.
+type LookThroughSectorConstraint struct {
+	Sector    string
+	MinWeight float64
+	MaxWeight float64
+}
+
+// OptimizeOptions configures a typed call to Tickers.OptimizeWithOptions. It
+// is serialized internally to the JSON strings accepted by Tickers.Optimize.
+type OptimizeOptions struct {
+	Objective                    Objective
+	AssetConstraints             []AssetConstraint
+	CategoryConstraints          []CategoryConstraint
+	GroupConstraints             []GroupConstraint
+	Turnover                     *TurnoverConstraint
+	LookThroughSectorConstraints []LookThroughSectorConstraint
+}
+
+// validateOptimizeOptions checks opts against symbols before any FFI call,
+// catching unknown symbols, infeasible bounds, and weight bounds that cannot
+// sum to a feasible portfolio.
+func validateOptimizeOptions(symbols []string, opts OptimizeOptions) error {
+	known := make(map[string]bool, len(symbols))
+	for _, s := range symbols {
+		known[s] = true
+	}
+
+	// Default bounds are [0, 1] per symbol; track the feasible sum assuming
+	// that default for any symbol without an explicit constraint.
+	minSum, maxSum := 0.0, float64(len(symbols))
+	for _, c := range opts.AssetConstraints {
+		if !known[c.Symbol] {
+			return fmt.Errorf("asset constraint references unknown symbol %q", c.Symbol)
+		}
+		if err := validateBounds(fmt.Sprintf("asset constraint for %q", c.Symbol), c.MinWeight, c.MaxWeight); err != nil {
+			return err
+		}
+		minSum += c.MinWeight
+		maxSum += c.MaxWeight - 1
+	}
+	if minSum > 1 {
+		return fmt.Errorf("asset constraint minimum weights sum to %.4f, which exceeds 1.0", minSum)
+	}
+	if maxSum < 1 {
+		return fmt.Errorf("asset constraint maximum weights sum to %.4f, which is below 1.0", maxSum)
+	}
+
+	assigned := make(map[string]string, len(symbols))
+	for _, c := range opts.CategoryConstraints {
+		if err := validateBounds(fmt.Sprintf("category constraint %q", c.Category), c.MinWeight, c.MaxWeight); err != nil {
+			return err
+		}
+		for _, m := range c.Members {
+			if !known[m] {
+				return fmt.Errorf("category constraint %q references unknown symbol %q", c.Category, m)
+			}
+			if prev, ok := assigned[m]; ok {
+				return fmt.Errorf("symbol %q belongs to both category %q and category %q", m, prev, c.Category)
+			}
+			assigned[m] = c.Category
+		}
+	}
+
+	for i, g := range opts.GroupConstraints {
+		if err := validateBounds(fmt.Sprintf("group constraint %d", i+1), g.MinWeight, g.MaxWeight); err != nil {
+			return err
+		}
+		for _, m := range g.Members {
+			if !known[m] {
+				return fmt.Errorf("group constraint %d references unknown symbol %q", i+1, m)
+			}
+		}
+	}
+
+	if t := opts.Turnover; t != nil {
+		if t.MaxTurnover < 0 {
+			return fmt.Errorf("turnover constraint max turnover %.4f must be non-negative", t.MaxTurnover)
+		}
+		for symbol := range t.InitialWeights {
+			if !known[symbol] {
+				return fmt.Errorf("turnover constraint references unknown symbol %q", symbol)
+			}
+		}
+	}
+
+	for _, c := range opts.LookThroughSectorConstraints {
+		if err := validateBounds(fmt.Sprintf("look-through sector constraint %q", c.Sector), c.MinWeight, c.MaxWeight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateBounds returns a descriptive error if min/max do not describe a
+// feasible weight range within [0, 1].
+func validateBounds(label string, min, max float64) error {
+	if min < 0 || max > 1 {
+		return fmt.Errorf("%s has out-of-range bounds [%.4f, %.4f]: weights must fall within [0, 1]", label, min, max)
+	}
+	if min > max {
+		return fmt.Errorf("%s has infeasible bounds: min weight %.4f exceeds max weight %.4f", label, min, max)
+	}
+	return nil
+}
+
+// buildAssetConstraintsJSON serializes per-asset weight bounds to the
+// `[[min,max],...]` JSON array expected by the FFI, ordered to match symbols
+// and defaulting to [0, 1] for any symbol without an explicit constraint.
+func buildAssetConstraintsJSON(symbols []string, constraints []AssetConstraint) (string, error) {
+	bounds := make(map[string][2]float64, len(constraints))
+	for _, c := range constraints {
+		bounds[c.Symbol] = [2]float64{c.MinWeight, c.MaxWeight}
+	}
+
+	pairs := make([][2]float64, len(symbols))
+	for i, s := range symbols {
+		if b, ok := bounds[s]; ok {
+			pairs[i] = b
+		} else {
+			pairs[i] = [2]float64{0, 1}
+		}
+	}
+
+	encoded, err := json.Marshal(pairs)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode asset constraints: %v", err)
+	}
+	return string(encoded), nil
+}
+
+// buildCategoricalConstraintsJSON serializes category and group constraints
+// to the `[{"Name":...,"Categories":[...],"Constraints":[[label,min,max],...]}]`
+// JSON array expected by the FFI. CategoryConstraints share a single
+// "Category" block since they partition symbols; each GroupConstraint gets
+// its own block since groups may overlap.
+func buildCategoricalConstraintsJSON(symbols []string, categories []CategoryConstraint, groups []GroupConstraint) (string, error) {
+	type block struct {
+		Name        string   `json:"Name"`
+		Categories  []string `json:"Categories"`
+		Constraints [][3]any `json:"Constraints"`
+	}
+	var blocks []block
+
+	if len(categories) > 0 {
+		memberOf := make(map[string]string, len(symbols))
+		for _, c := range categories {
+			for _, m := range c.Members {
+				memberOf[m] = c.Category
+			}
+		}
+		labels := make([]string, len(symbols))
+		for i, s := range symbols {
+			labels[i] = memberOf[s]
+		}
+		constraints := make([][3]any, len(categories))
+		for i, c := range categories {
+			constraints[i] = [3]any{c.Category, c.MinWeight, c.MaxWeight}
+		}
+		blocks = append(blocks, block{Name: "Category", Categories: labels, Constraints: constraints})
+	}
+
+	for i, g := range groups {
+		memberSet := make(map[string]bool, len(g.Members))
+		for _, m := range g.Members {
+			memberSet[m] = true
+		}
+		labels := make([]string, len(symbols))
+		for j, s := range symbols {
+			if memberSet[s] {
+				labels[j] = "in"
+			} else {
+				labels[j] = "out"
+			}
+		}
+		blocks = append(blocks, block{
+			Name:        fmt.Sprintf("Group%d", i+1),
+			Categories:  labels,
+			Constraints: [][3]any{{"in", g.MinWeight, g.MaxWeight}},
+		})
+	}
+
+	if blocks == nil {
+		return "[]", nil
+	}
+	encoded, err := json.Marshal(blocks)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode categorical constraints: %v", err)
+	}
+	return string(encoded), nil
+}
+
+// buildWeightsJSON serializes a turnover constraint to the portfolio-level
+// weights JSON expected by the FFI: the supplied initial weights (ordered to
+// match symbols, defaulting to 0 for symbols not present) and the maximum
+// allowed L1 turnover.
+func buildWeightsJSON(symbols []string, turnover *TurnoverConstraint) (string, error) {
+	if turnover == nil {
+		return "{}", nil
+	}
+
+	initial := make([]float64, len(symbols))
+	for i, s := range symbols {
+		initial[i] = turnover.InitialWeights[s]
+	}
+
+	payload := map[string]any{
+		"initial_weights": initial,
+		"max_turnover":    turnover.MaxTurnover,
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode turnover constraint: %v", err)
+	}
+	return string(encoded), nil
+}
@@ -0,0 +1,70 @@
+package finalytics
+
+/*
+#include <finalytics.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"context"
+
+	"github.com/go-gota/gota/dataframe"
+)
+
+// runDataFrameFFI runs fn, a cgo call that returns a JSON dataframe payload,
+// on a goroutine and returns early with ctx.Err() if ctx is done first. code
+// is classified into a typed error via classifyFFIError(action, ...) on failure.
+func runDataFrameFFI(ctx context.Context, action string, fn func() (*C.char, C.int)) (dataframe.DataFrame, error) {
+	if err := waitRateLimit(ctx); err != nil {
+		return dataframe.DataFrame{}, err
+	}
+	type result struct {
+		df  dataframe.DataFrame
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		cOutput, code := fn()
+		if code != 0 {
+			done <- result{dataframe.DataFrame{}, classifyFFIError(action, int(code))}
+			return
+		}
+		df, err := parseJSONToDataFrame(cOutput)
+		done <- result{df, err}
+	}()
+	select {
+	case r := <-done:
+		return r.df, r.err
+	case <-ctx.Done():
+		return dataframe.DataFrame{}, ctx.Err()
+	}
+}
+
+// runMapFFI runs fn, a cgo call that returns a JSON object payload, on a
+// goroutine and returns early with ctx.Err() if ctx is done first. code is
+// classified into a typed error via classifyFFIError(action, ...) on failure.
+func runMapFFI(ctx context.Context, action string, fn func() (*C.char, C.int)) (map[string]any, error) {
+	if err := waitRateLimit(ctx); err != nil {
+		return nil, err
+	}
+	type result struct {
+		data map[string]any
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		cOutput, code := fn()
+		if code != 0 {
+			done <- result{nil, classifyFFIError(action, int(code))}
+			return
+		}
+		data, err := parseJSONResult(cOutput)
+		done <- result{data, err}
+	}()
+	select {
+	case r := <-done:
+		return r.data, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
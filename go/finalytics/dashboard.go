@@ -0,0 +1,323 @@
+package finalytics
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// DashboardSection groups a Dashboard's panels into a tabbed section.
+type DashboardSection string
+
+const (
+	PerformanceSection DashboardSection = "Performance"
+	FinancialsSection  DashboardSection = "Financials"
+	OptionsSection     DashboardSection = "Options"
+	NewsSection        DashboardSection = "News"
+)
+
+// Panel is one cell of a Dashboard's grid: a chart panel built by one of the
+// Ticker*Panel/Portfolio*Panel constructors, a MarkdownPanel commentary
+// block, or any other caller-assembled Panel.
+type Panel struct {
+	// Title is shown above the panel's content.
+	Title string
+	// Section assigns the panel to one of the Dashboard's tabs.
+	Section DashboardSection
+	// Render produces the panel's HTML content. Called concurrently with
+	// every other panel's Render by Dashboard.Render; ignored if Markdown
+	// is set.
+	Render func() (HTML, error)
+	// Markdown, if non-empty, is rendered as the panel's content via a
+	// minimal Markdown-to-HTML conversion instead of calling Render.
+	Markdown string
+}
+
+// DashboardLayout sizes a Dashboard section's panel grid.
+type DashboardLayout struct {
+	Rows, Cols int
+}
+
+// Dashboard composes chart panels from one or more Tickers and/or a
+// Portfolio, plus optional Markdown commentary, into a single HTML page:
+// one tab per DashboardSection, each laid out as a responsive grid. Unlike
+// Ticker.Report/Portfolio.Report's single canned report, a Dashboard is
+// assembled panel by panel, so a research-publishing workflow can mix
+// charts from several tickers and a portfolio on one page.
+//
+// Example:
+//   dashboard := finalytics.NewDashboard().
+//   	Title("AAPL Research Note").
+//   	Layout(2, 2).
+//   	AddPanel(finalytics.TickerPerformancePanel("Performance", ticker, 0, 0)).
+//   	AddPanel(finalytics.TickerCandlestickPanel("Price", ticker, 0, 0)).
+//   	AddPanel(finalytics.MarkdownPanel("Thesis", finalytics.PerformanceSection, "## Thesis\n\nLong on margin expansion."))
+//   html, err := dashboard.Render()
+type Dashboard struct {
+	title  string
+	layout DashboardLayout
+	panels []Panel
+}
+
+// NewDashboard creates a Dashboard with a 2x2 default grid layout and no panels.
+func NewDashboard() *Dashboard {
+	return &Dashboard{layout: DashboardLayout{Rows: 2, Cols: 2}}
+}
+
+// Title sets the dashboard page's heading.
+//
+// Returns:
+//   - *Dashboard: The dashboard instance for method chaining.
+func (d *Dashboard) Title(title string) *Dashboard {
+	d.title = title
+	return d
+}
+
+// Layout sets the number of rows and columns each section's panel grid
+// targets; a section with more panels than rows*cols simply wraps.
+//
+// Returns:
+//   - *Dashboard: The dashboard instance for method chaining.
+func (d *Dashboard) Layout(rows, cols int) *Dashboard {
+	d.layout = DashboardLayout{Rows: rows, Cols: cols}
+	return d
+}
+
+// AddPanel appends panel to the dashboard.
+//
+// Returns:
+//   - *Dashboard: The dashboard instance for method chaining.
+func (d *Dashboard) AddPanel(panel Panel) *Dashboard {
+	d.panels = append(d.panels, panel)
+	return d
+}
+
+// TickerPerformancePanel builds a Panel rendering t.PerformanceChart under
+// PerformanceSection.
+func TickerPerformancePanel(title string, t *Ticker, height, width uint, opts ...ChartOptions) Panel {
+	return Panel{Title: title, Section: PerformanceSection, Render: func() (HTML, error) {
+		return t.PerformanceChart(height, width, opts...)
+	}}
+}
+
+// TickerCandlestickPanel builds a Panel rendering t.CandlestickChart under
+// PerformanceSection.
+func TickerCandlestickPanel(title string, t *Ticker, height, width uint, opts ...ChartOptions) Panel {
+	return Panel{Title: title, Section: PerformanceSection, Render: func() (HTML, error) {
+		return t.CandlestickChart(height, width, opts...)
+	}}
+}
+
+// TickerOptionsPanel builds a Panel rendering t.OptionsChart under OptionsSection.
+func TickerOptionsPanel(title string, t *Ticker, chartType string, height, width uint, opts ...ChartOptions) Panel {
+	return Panel{Title: title, Section: OptionsSection, Render: func() (HTML, error) {
+		return t.OptionsChart(chartType, height, width, opts...)
+	}}
+}
+
+// TickerNewsPanel builds a Panel rendering t.NewsSentimentChart under NewsSection.
+func TickerNewsPanel(title string, t *Ticker, height, width uint, opts ...ChartOptions) Panel {
+	return Panel{Title: title, Section: NewsSection, Render: func() (HTML, error) {
+		return t.NewsSentimentChart(height, width, opts...)
+	}}
+}
+
+// PortfolioPerformancePanel builds a Panel rendering p.PerformanceChart
+// under PerformanceSection.
+func PortfolioPerformancePanel(title string, p *Portfolio, height, width uint, opts ...ChartOptions) Panel {
+	return Panel{Title: title, Section: PerformanceSection, Render: func() (HTML, error) {
+		return p.PerformanceChart(height, width, opts...)
+	}}
+}
+
+// PortfolioOptimizationPanel builds a Panel rendering p.OptimizationChart
+// under PerformanceSection.
+func PortfolioOptimizationPanel(title string, p *Portfolio, height, width uint) Panel {
+	return Panel{Title: title, Section: PerformanceSection, Render: func() (HTML, error) {
+		return p.OptimizationChart(height, width)
+	}}
+}
+
+// PortfolioAssetReturnsPanel builds a Panel rendering p.AssetReturnsChart
+// under PerformanceSection.
+func PortfolioAssetReturnsPanel(title string, p *Portfolio, height, width uint) Panel {
+	return Panel{Title: title, Section: PerformanceSection, Render: func() (HTML, error) {
+		return p.AssetReturnsChart(height, width)
+	}}
+}
+
+// MarkdownPanel builds a commentary Panel rendering markdown under section.
+func MarkdownPanel(title string, section DashboardSection, markdown string) Panel {
+	return Panel{Title: title, Section: section, Markdown: markdown}
+}
+
+// renderedPanel holds one panel's rendered content or error, keeping the
+// panels slice's order (runBatchPool preserves input order) so Render
+// places panels in section/grid order rather than goroutine completion order.
+type renderedPanel struct {
+	panel   Panel
+	content string
+	err     error
+}
+
+// Render renders every panel concurrently (sharing TickerBatch's
+// runBatchPool worker pool) and assembles them into a tabbed, gridded HTML
+// page: one tab per distinct Section, in first-use order. A panel that
+// fails to render is replaced inline with its error message instead of
+// failing the whole dashboard; Render itself only errors if every panel
+// failed, or none were added.
+//
+// Returns:
+//   - HTML: The assembled dashboard page.
+//   - error: An error if there are no panels, or every panel failed to render.
+//
+// Example:
+//   html, err := dashboard.Render()
+//   if err != nil {
+//   	fmt.Printf("Failed to render dashboard: %v\n", err)
+//   	return
+//   }
+//   html.Show()
+func (d *Dashboard) Render() (HTML, error) {
+	if len(d.panels) == 0 {
+		return HTML{}, fmt.Errorf("dashboard has no panels; add one with Dashboard.AddPanel")
+	}
+
+	rendered := runBatchPool(d.panels, 0, func(p Panel) renderedPanel {
+		if p.Markdown != "" {
+			return renderedPanel{panel: p, content: markdownToHTML(p.Markdown)}
+		}
+		if p.Render == nil {
+			return renderedPanel{panel: p, err: fmt.Errorf("panel %q has neither Render nor Markdown set", p.Title)}
+		}
+		out, err := p.Render()
+		if err != nil {
+			return renderedPanel{panel: p, err: err}
+		}
+		return renderedPanel{panel: p, content: out.Content}
+	})
+
+	var failures int
+	var sectionOrder []DashboardSection
+	sections := make(map[DashboardSection][]renderedPanel)
+	for _, r := range rendered {
+		if r.err != nil {
+			failures++
+		}
+		if _, ok := sections[r.panel.Section]; !ok {
+			sectionOrder = append(sectionOrder, r.panel.Section)
+		}
+		sections[r.panel.Section] = append(sections[r.panel.Section], r)
+	}
+	if failures == len(rendered) {
+		return HTML{}, fmt.Errorf("every panel failed to render; first error: %w", firstPanelError(rendered))
+	}
+
+	return HTML{Content: renderDashboardHTML(d.title, d.layout, sectionOrder, sections)}, nil
+}
+
+// firstPanelError returns the first non-nil error in rendered.
+func firstPanelError(rendered []renderedPanel) error {
+	for _, r := range rendered {
+		if r.err != nil {
+			return r.err
+		}
+	}
+	return nil
+}
+
+// ServeHTTP renders the dashboard and writes it as the response body,
+// making a *Dashboard mountable directly on an http.ServeMux.
+func (d *Dashboard) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	out, err := d.Render()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(out.Content))
+}
+
+// renderDashboardHTML assembles a self-contained HTML page with one tab per
+// entry in sectionOrder and a responsive CSS grid (layout.Rows x
+// layout.Cols, collapsing to one column on narrow viewports) of panels
+// within each.
+func renderDashboardHTML(title string, layout DashboardLayout, sectionOrder []DashboardSection, sections map[DashboardSection][]renderedPanel) string {
+	var tabs, bodies strings.Builder
+	for i, section := range sectionOrder {
+		tabID := fmt.Sprintf("section-%d", i)
+		display, active := "none", ""
+		if i == 0 {
+			display, active = "block", " active"
+		}
+		fmt.Fprintf(&tabs, `<button class="tab%s" onclick="document.querySelectorAll('.section').forEach(function(s){s.style.display='none';});document.getElementById('%s').style.display='block';document.querySelectorAll('.tab').forEach(function(t){t.classList.remove('active');});this.classList.add('active');">%s</button>`,
+			active, tabID, html.EscapeString(string(section)))
+
+		var panels strings.Builder
+		for _, r := range sections[section] {
+			content := r.content
+			if r.err != nil {
+				content = fmt.Sprintf(`<div class="panel-error">Failed to render panel: %s</div>`, html.EscapeString(r.err.Error()))
+			}
+			fmt.Fprintf(&panels, `<div class="panel"><h3>%s</h3><div class="panel-body">%s</div></div>`, html.EscapeString(r.panel.Title), content)
+		}
+		fmt.Fprintf(&bodies, `<div class="section" id="%s" style="display:%s"><div class="grid">%s</div></div>`, tabID, display, panels.String())
+	}
+
+	var heading string
+	if title != "" {
+		heading = fmt.Sprintf("<h1>%s</h1>", html.EscapeString(title))
+	}
+
+	return fmt.Sprintf(`<!DOCTYPE html><html><head><meta charset="utf-8"><title>%s</title>
+<style>
+body { font-family: sans-serif; margin: 1rem; }
+.tab { padding: 0.5rem 1rem; margin-right: 0.25rem; cursor: pointer; border: 1px solid #ccc; background: #f5f5f5; }
+.tab.active { background: #fff; border-bottom: 2px solid #333; font-weight: bold; }
+.grid { display: grid; grid-template-columns: repeat(%d, minmax(300px, 1fr)); grid-template-rows: repeat(%d, auto); gap: 1rem; margin-top: 1rem; }
+.panel { border: 1px solid #ddd; border-radius: 4px; padding: 0.5rem; overflow: auto; }
+.panel-error { color: #b00020; }
+@media (max-width: 600px) { .grid { grid-template-columns: 1fr; } }
+</style></head>
+<body>%s%s%s</body></html>`, html.EscapeString(title), layout.Cols, layout.Rows, heading, tabs.String(), bodies.String())
+}
+
+var (
+	markdownBold   = regexp.MustCompile(`\*\*(.+?)\*\*`)
+	markdownItalic = regexp.MustCompile(`\*(.+?)\*`)
+)
+
+// markdownToHTML is a minimal, dependency-free Markdown renderer covering
+// the subset a commentary panel needs: #/##/### headings, **bold**,
+// *italic*, and blank-line-separated paragraphs. It is not a full
+// CommonMark implementation.
+func markdownToHTML(markdown string) string {
+	var out strings.Builder
+	for _, block := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n\n") {
+		block = strings.TrimSpace(block)
+		if block == "" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(block, "### "):
+			fmt.Fprintf(&out, "<h3>%s</h3>", inlineMarkdown(strings.TrimPrefix(block, "### ")))
+		case strings.HasPrefix(block, "## "):
+			fmt.Fprintf(&out, "<h2>%s</h2>", inlineMarkdown(strings.TrimPrefix(block, "## ")))
+		case strings.HasPrefix(block, "# "):
+			fmt.Fprintf(&out, "<h1>%s</h1>", inlineMarkdown(strings.TrimPrefix(block, "# ")))
+		default:
+			fmt.Fprintf(&out, "<p>%s</p>", inlineMarkdown(block))
+		}
+	}
+	return out.String()
+}
+
+// inlineMarkdown HTML-escapes block, then applies **bold** and *italic*.
+func inlineMarkdown(block string) string {
+	escaped := html.EscapeString(block)
+	escaped = markdownBold.ReplaceAllString(escaped, "<strong>$1</strong>")
+	escaped = markdownItalic.ReplaceAllString(escaped, "<em>$1</em>")
+	return escaped
+}
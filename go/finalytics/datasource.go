@@ -0,0 +1,236 @@
+package finalytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// DataSource fetches OHLCV price history for a symbol from an external
+// provider, returning it in the "timestamp", "open", "high", "low", "close",
+// "volume" column layout expected by TickerBuilder.TickerData and
+// TickerBuilder.BenchmarkData. Implementations let Ticker/Tickers/Portfolio
+// use a provider other than the Rust FFI's built-in Yahoo Finance fetch.
+type DataSource interface {
+	FetchOHLCV(ctx context.Context, symbol, startDate, endDate, interval string) (dataframe.DataFrame, error)
+}
+
+// httpGetJSON issues a GET request against rawURL and decodes the JSON body into v.
+func httpGetJSON(ctx context.Context, rawURL string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %v", rawURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", rawURL, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %v", rawURL, err)
+	}
+	return nil
+}
+
+// ohlcvFrame builds the standard OHLCV DataFrame shared by every DataSource implementation.
+func ohlcvFrame(timestamps []string, open, high, low, close []float64, volume []int) dataframe.DataFrame {
+	return dataframe.New(
+		series.New(timestamps, series.String, "timestamp"),
+		series.New(open, series.Float, "open"),
+		series.New(high, series.Float, "high"),
+		series.New(low, series.Float, "low"),
+		series.New(close, series.Float, "close"),
+		series.New(volume, series.Int, "volume"),
+	)
+}
+
+// TiingoSource fetches daily OHLCV history from the Tiingo REST API.
+// See https://api.tiingo.com/documentation/end-of-day.
+type TiingoSource struct {
+	APIKey string
+}
+
+type tiingoBar struct {
+	Date   string  `json:"date"`
+	Open   float64 `json:"open"`
+	High   float64 `json:"high"`
+	Low    float64 `json:"low"`
+	Close  float64 `json:"close"`
+	Volume int     `json:"volume"`
+}
+
+// FetchOHLCV retrieves symbol's daily price history from Tiingo between startDate and endDate.
+func (s TiingoSource) FetchOHLCV(ctx context.Context, symbol, startDate, endDate, interval string) (dataframe.DataFrame, error) {
+	u := fmt.Sprintf("https://api.tiingo.com/tiingo/daily/%s/prices?startDate=%s&endDate=%s&token=%s",
+		url.PathEscape(symbol), url.QueryEscape(startDate), url.QueryEscape(endDate), url.QueryEscape(s.APIKey))
+	var bars []tiingoBar
+	if err := httpGetJSON(ctx, u, &bars); err != nil {
+		return dataframe.DataFrame{}, err
+	}
+	return barsToFrame(bars), nil
+}
+
+func barsToFrame(bars []tiingoBar) dataframe.DataFrame {
+	n := len(bars)
+	timestamps := make([]string, n)
+	open, high, low, close := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	volume := make([]int, n)
+	for i, b := range bars {
+		timestamps[i], open[i], high[i], low[i], close[i], volume[i] = b.Date, b.Open, b.High, b.Low, b.Close, b.Volume
+	}
+	return ohlcvFrame(timestamps, open, high, low, close, volume)
+}
+
+// AlphaVantageSource fetches daily OHLCV history from the Alpha Vantage TIME_SERIES_DAILY endpoint.
+// See https://www.alphavantage.co/documentation/#daily.
+type AlphaVantageSource struct {
+	APIKey string
+}
+
+type alphaVantageResponse struct {
+	TimeSeries map[string]struct {
+		Open   string `json:"1. open"`
+		High   string `json:"2. high"`
+		Low    string `json:"3. low"`
+		Close  string `json:"4. close"`
+		Volume string `json:"5. volume"`
+	} `json:"Time Series (Daily)"`
+}
+
+// FetchOHLCV retrieves symbol's daily price history from Alpha Vantage.
+// startDate, endDate and interval are accepted for interface parity but Alpha
+// Vantage's free daily endpoint always returns its full available history,
+// which callers should slice down to the desired window.
+func (s AlphaVantageSource) FetchOHLCV(ctx context.Context, symbol, startDate, endDate, interval string) (dataframe.DataFrame, error) {
+	u := fmt.Sprintf("https://www.alphavantage.co/query?function=TIME_SERIES_DAILY&symbol=%s&apikey=%s",
+		url.QueryEscape(symbol), url.QueryEscape(s.APIKey))
+	var resp alphaVantageResponse
+	if err := httpGetJSON(ctx, u, &resp); err != nil {
+		return dataframe.DataFrame{}, err
+	}
+
+	n := len(resp.TimeSeries)
+	timestamps := make([]string, 0, n)
+	open, high, low, close := make([]float64, 0, n), make([]float64, 0, n), make([]float64, 0, n), make([]float64, 0, n)
+	volume := make([]int, 0, n)
+	for date, bar := range resp.TimeSeries {
+		timestamps = append(timestamps, date)
+		open = append(open, parseFloatOrZero(bar.Open))
+		high = append(high, parseFloatOrZero(bar.High))
+		low = append(low, parseFloatOrZero(bar.Low))
+		close = append(close, parseFloatOrZero(bar.Close))
+		volume = append(volume, int(parseFloatOrZero(bar.Volume)))
+	}
+	return ohlcvFrame(timestamps, open, high, low, close, volume), nil
+}
+
+func parseFloatOrZero(s string) float64 {
+	v, _ := strconv.ParseFloat(s, 64)
+	return v
+}
+
+// IEXSource fetches OHLCV history from the IEX Cloud historical prices endpoint.
+// See https://iexcloud.io/docs/api/#historical-prices.
+type IEXSource struct {
+	APIKey string
+}
+
+type iexBar struct {
+	Date   string  `json:"date"`
+	Open   float64 `json:"open"`
+	High   float64 `json:"high"`
+	Low    float64 `json:"low"`
+	Close  float64 `json:"close"`
+	Volume int     `json:"volume"`
+}
+
+// FetchOHLCV retrieves symbol's daily price history from IEX Cloud.
+func (s IEXSource) FetchOHLCV(ctx context.Context, symbol, startDate, endDate, interval string) (dataframe.DataFrame, error) {
+	u := fmt.Sprintf("https://cloud.iexapis.com/stable/stock/%s/chart/max?token=%s",
+		url.PathEscape(symbol), url.QueryEscape(s.APIKey))
+	var bars []iexBar
+	if err := httpGetJSON(ctx, u, &bars); err != nil {
+		return dataframe.DataFrame{}, err
+	}
+
+	n := len(bars)
+	timestamps := make([]string, n)
+	open, high, low, close := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	volume := make([]int, n)
+	for i, b := range bars {
+		timestamps[i], open[i], high[i], low[i], close[i], volume[i] = b.Date, b.Open, b.High, b.Low, b.Close, b.Volume
+	}
+	return ohlcvFrame(timestamps, open, high, low, close, volume), nil
+}
+
+// MarketDataAppSource fetches OHLCV history from the Market Data App candles endpoint.
+// See https://www.marketdata.app/docs/api/stocks/candles.
+type MarketDataAppSource struct {
+	APIKey string
+}
+
+type marketDataAppResponse struct {
+	T []int64   `json:"t"`
+	O []float64 `json:"o"`
+	H []float64 `json:"h"`
+	L []float64 `json:"l"`
+	C []float64 `json:"c"`
+	V []int     `json:"v"`
+}
+
+// FetchOHLCV retrieves symbol's daily price history from Market Data App between startDate and endDate.
+func (s MarketDataAppSource) FetchOHLCV(ctx context.Context, symbol, startDate, endDate, interval string) (dataframe.DataFrame, error) {
+	u := fmt.Sprintf("https://api.marketdata.app/v1/stocks/candles/D/%s?from=%s&to=%s&token=%s",
+		url.PathEscape(symbol), url.QueryEscape(startDate), url.QueryEscape(endDate), url.QueryEscape(s.APIKey))
+	var resp marketDataAppResponse
+	if err := httpGetJSON(ctx, u, &resp); err != nil {
+		return dataframe.DataFrame{}, err
+	}
+
+	timestamps := make([]string, len(resp.T))
+	for i, t := range resp.T {
+		timestamps[i] = strconv.FormatInt(t, 10)
+	}
+	return ohlcvFrame(timestamps, resp.O, resp.H, resp.L, resp.C, resp.V), nil
+}
+
+// NewTickerBuilderFromSource fetches symbol's OHLCV history from source and
+// returns a TickerBuilder pre-populated via TickerData, so the Ticker built
+// from it uses source instead of the FFI's built-in Yahoo Finance fetch.
+//
+// Parameters:
+//   - ctx: A context.Context used to cancel the fetch.
+//   - source: The DataSource to fetch history from (e.g. TiingoSource).
+//   - symbol: The ticker symbol to fetch.
+//   - startDate, endDate: The data period in YYYY-MM-DD format.
+//   - interval: The data interval (passed through to source.FetchOHLCV).
+//
+// Returns:
+//   - *TickerBuilder: A builder with Symbol, dates, interval, and TickerData already set.
+//   - error: An error if the fetch fails.
+//
+// Example:
+//   builder, err := finalytics.NewTickerBuilderFromSource(ctx, finalytics.TiingoSource{APIKey: key},
+//       "AAPL", "2023-01-01", "2023-12-31", "1d")
+//   ticker, err := builder.Build()
+func NewTickerBuilderFromSource(ctx context.Context, source DataSource, symbol, startDate, endDate, interval string) (*TickerBuilder, error) {
+	data, err := source.FetchOHLCV(ctx, symbol, startDate, endDate, interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OHLCV from data source: %v", err)
+	}
+	return NewTickerBuilder().
+		Symbol(symbol).
+		StartDate(startDate).
+		EndDate(endDate).
+		Interval(interval).
+		TickerData(&data), nil
+}
@@ -0,0 +1,44 @@
+package finalytics
+
+import "fmt"
+
+// Sentinel errors surfaced by the *Context FFI variants (e.g.
+// GetPriceHistoryContext, GetNewsContext) in place of the opaque
+// "error code %d" strings returned by their synchronous counterparts.
+// Callers can check for these with errors.Is.
+var (
+	// ErrRateLimited indicates the underlying data provider rejected the
+	// request for being rate limited (HTTP 429 or equivalent).
+	ErrRateLimited = fmt.Errorf("finalytics: rate limited by upstream provider")
+	// ErrSymbolNotFound indicates the requested symbol does not exist or
+	// could not be resolved by the underlying data provider.
+	ErrSymbolNotFound = fmt.Errorf("finalytics: symbol not found")
+	// ErrNetwork indicates the underlying FFI call failed due to a
+	// network-level error (timeout, DNS failure, connection reset).
+	ErrNetwork = fmt.Errorf("finalytics: network error")
+)
+
+// finalytics FFI error codes. These mirror the error enum returned by the
+// underlying Rust library; any code not matched below falls back to a
+// generic "error code %d" error.
+const (
+	ffiErrRateLimited   = -429
+	ffiErrSymbolUnknown = -404
+	ffiErrNetwork       = -1
+)
+
+// classifyFFIError maps a raw FFI error code to one of the package's typed
+// sentinel errors, falling back to a generic error carrying the code when
+// it isn't one of the recognized cases.
+func classifyFFIError(action string, code int) error {
+	switch code {
+	case ffiErrRateLimited:
+		return fmt.Errorf("failed to %s: %w", action, ErrRateLimited)
+	case ffiErrSymbolUnknown:
+		return fmt.Errorf("failed to %s: %w", action, ErrSymbolNotFound)
+	case ffiErrNetwork:
+		return fmt.Errorf("failed to %s: %w", action, ErrNetwork)
+	default:
+		return fmt.Errorf("failed to %s: error code %d", action, code)
+	}
+}
@@ -0,0 +1,273 @@
+package finalytics
+
+/*
+#include <finalytics.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/go-gota/gota/dataframe"
+)
+
+// Candle is a single OHLCV bar. For FundCandles, Close doubles as the fund's
+// daily net asset value (NAV) per share.
+type Candle struct {
+	Date   string
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// Holding is a single position within a mutual fund or ETF's portfolio.
+type Holding struct {
+	Symbol string
+	Name   string
+	Sector string
+	Weight float64 // fraction of the fund's portfolio, e.g. 0.05 for 5%
+}
+
+// FundCandles retrieves the daily NAV (net asset value) series for a mutual
+// fund or ETF symbol within the tickers.
+//
+// Parameters:
+//   - symbol: The fund or ETF symbol (e.g., "SPY").
+//
+// Returns:
+//   - []Candle: The fund's daily NAV series, one Candle per trading day.
+//   - error: An error if the NAV series retrieval fails.
+//
+// Example:
+//   candles, err := tickers.FundCandles("SPY")
+func (t *Tickers) FundCandles(symbol string) ([]Candle, error) {
+	df, err := t.fundDataFrame(symbol, fundEndpointCandles, "fund candles")
+	if err != nil {
+		return nil, err
+	}
+
+	dates := df.Col("date").Records()
+	opens := df.Col("open").Float()
+	highs := df.Col("high").Float()
+	lows := df.Col("low").Float()
+	closes := df.Col("close").Float()
+	volumes := df.Col("volume").Float()
+
+	candles := make([]Candle, len(dates))
+	for i, date := range dates {
+		candles[i] = Candle{
+			Date:   date,
+			Open:   floatAt(opens, i),
+			High:   floatAt(highs, i),
+			Low:    floatAt(lows, i),
+			Close:  floatAt(closes, i),
+			Volume: floatAt(volumes, i),
+		}
+	}
+	return candles, nil
+}
+
+// FundHoldings retrieves the top holdings and their portfolio weights for a
+// mutual fund or ETF symbol within the tickers.
+//
+// Parameters:
+//   - symbol: The fund or ETF symbol (e.g., "SPY").
+//
+// Returns:
+//   - []Holding: The fund's top holdings, ordered as returned by the data source.
+//   - error: An error if the holdings retrieval fails.
+//
+// Example:
+//   holdings, err := tickers.FundHoldings("SPY")
+func (t *Tickers) FundHoldings(symbol string) ([]Holding, error) {
+	df, err := t.fundDataFrame(symbol, fundEndpointHoldings, "fund holdings")
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := df.Col("symbol").Records()
+	names := df.Col("name").Records()
+	sectors := df.Col("sector").Records()
+	weights := df.Col("weight").Float()
+
+	holdings := make([]Holding, len(symbols))
+	for i, s := range symbols {
+		holdings[i] = Holding{
+			Symbol: s,
+			Name:   stringAt(names, i),
+			Sector: stringAt(sectors, i),
+			Weight: floatAt(weights, i),
+		}
+	}
+	return holdings, nil
+}
+
+// FundSectorBreakdown retrieves a mutual fund or ETF's sector exposure.
+//
+// Parameters:
+//   - symbol: The fund or ETF symbol (e.g., "SPY").
+//
+// Returns:
+//   - map[string]float64: Sector name to fraction of the fund's portfolio (e.g. 0.28 for 28% in "Technology").
+//   - error: An error if the sector breakdown retrieval fails.
+//
+// Example:
+//   breakdown, err := tickers.FundSectorBreakdown("SPY")
+func (t *Tickers) FundSectorBreakdown(symbol string) (map[string]float64, error) {
+	cSymbol := C.CString(symbol)
+	defer C.free(unsafe.Pointer(cSymbol))
+	var cOutput *C.char
+	result := C.finalytics_tickers_fund_sector_breakdown(t.handle, cSymbol, &cOutput)
+	if result != 0 {
+		return nil, fmt.Errorf("failed to get fund sector breakdown for %q: error code %d", symbol, result)
+	}
+	raw, err := parseJSONResult(cOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown := make(map[string]float64, len(raw))
+	for sector, v := range raw {
+		if weight, ok := v.(float64); ok {
+			breakdown[sector] = weight
+		}
+	}
+	return breakdown, nil
+}
+
+// FundExpenseRatio retrieves a mutual fund or ETF's annual expense ratio.
+//
+// Parameters:
+//   - symbol: The fund or ETF symbol (e.g., "SPY").
+//
+// Returns:
+//   - float64: The fund's expense ratio exactly as reported in the response's "expenseRatio" field, with no unit conversion.
+//   - error: An error if the expense ratio retrieval fails.
+//
+// Example:
+//   expenseRatio, err := tickers.FundExpenseRatio("SPY")
+func (t *Tickers) FundExpenseRatio(symbol string) (float64, error) {
+	cSymbol := C.CString(symbol)
+	defer C.free(unsafe.Pointer(cSymbol))
+	var cOutput *C.char
+	result := C.finalytics_tickers_fund_expense_ratio(t.handle, cSymbol, &cOutput)
+	if result != 0 {
+		return 0, fmt.Errorf("failed to get fund expense ratio for %q: error code %d", symbol, result)
+	}
+	raw, err := parseJSONResult(cOutput)
+	if err != nil {
+		return 0, err
+	}
+	ratio, ok := raw["expenseRatio"].(float64)
+	if !ok {
+		return 0, fmt.Errorf("fund expense ratio response for %q did not contain a numeric \"expenseRatio\"", symbol)
+	}
+	return ratio, nil
+}
+
+// lookThroughSectorExposure computes a portfolio's combined exposure to each
+// sector once every fund or ETF symbol in weights is expanded into its
+// underlying holdings via FundSectorBreakdown. A symbol that FundSectorBreakdown
+// fails for (most plausibly because it is not a fund) is treated as wholly
+// unclassified and does not contribute to any sector.
+func (t *Tickers) lookThroughSectorExposure(weights map[string]float64) (map[string]float64, error) {
+	exposure := make(map[string]float64)
+	for symbol, weight := range weights {
+		if weight == 0 {
+			continue
+		}
+		breakdown, err := t.FundSectorBreakdown(symbol)
+		if err != nil {
+			continue
+		}
+		for sector, fraction := range breakdown {
+			exposure[sector] += weight * fraction
+		}
+	}
+	return exposure, nil
+}
+
+// extractOptimizedWeights reads the per-symbol weights out of the map
+// returned by Portfolio.OptimizationResults, keyed by symbol. It
+// accepts either a `{"symbol": weight, ...}` map or a `[weight, ...]` array
+// aligned with symbols, since the exact shape is not documented by the FFI.
+func extractOptimizedWeights(results map[string]any, symbols []string) (map[string]float64, error) {
+	raw, ok := results["weights"]
+	if !ok {
+		return nil, fmt.Errorf("optimization results did not contain a \"weights\" field")
+	}
+
+	weights := make(map[string]float64, len(symbols))
+	switch v := raw.(type) {
+	case map[string]any:
+		for _, symbol := range symbols {
+			if w, ok := v[symbol].(float64); ok {
+				weights[symbol] = w
+			}
+		}
+	case []any:
+		for i, symbol := range symbols {
+			if i >= len(v) {
+				break
+			}
+			if w, ok := v[i].(float64); ok {
+				weights[symbol] = w
+			}
+		}
+	default:
+		return nil, fmt.Errorf("optimization results \"weights\" field had unexpected type %T", raw)
+	}
+	return weights, nil
+}
+
+// fundEndpoint selects which symbol-scoped fund FFI call fundDataFrame issues.
+// A referenced-but-uncalled cgo function (e.g. C.finalytics_tickers_fund_candles)
+// is an opaque pointer value, not a Go func value, so the call can't be
+// passed in as a parameter the way a plain Go function could; fundDataFrame
+// switches on this selector and calls each FFI function explicitly instead.
+type fundEndpoint int
+
+const (
+	fundEndpointCandles fundEndpoint = iota
+	fundEndpointHoldings
+)
+
+// fundDataFrame issues a symbol-scoped fund FFI call and parses its JSON
+// DataFrame result, wrapping errors with label for context.
+func (t *Tickers) fundDataFrame(symbol string, endpoint fundEndpoint, label string) (dataframe.DataFrame, error) {
+	cSymbol := C.CString(symbol)
+	defer C.free(unsafe.Pointer(cSymbol))
+	var cOutput *C.char
+	var result C.int
+	switch endpoint {
+	case fundEndpointCandles:
+		result = C.finalytics_tickers_fund_candles(t.handle, cSymbol, &cOutput)
+	case fundEndpointHoldings:
+		result = C.finalytics_tickers_fund_holdings(t.handle, cSymbol, &cOutput)
+	default:
+		return dataframe.DataFrame{}, fmt.Errorf("unknown fund endpoint %d", endpoint)
+	}
+	if result != 0 {
+		return dataframe.DataFrame{}, fmt.Errorf("failed to get %s for %q: error code %d", label, symbol, result)
+	}
+	return parseJSONToDataFrame(cOutput)
+}
+
+// floatAt returns values[i], or 0 if i is out of range.
+func floatAt(values []float64, i int) float64 {
+	if i < 0 || i >= len(values) {
+		return 0
+	}
+	return values[i]
+}
+
+// stringAt returns values[i], or "" if i is out of range.
+func stringAt(values []string, i int) string {
+	if i < 0 || i >= len(values) {
+		return ""
+	}
+	return values[i]
+}
@@ -0,0 +1,261 @@
+package finalytics
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// Renderer displays an HTML chart, table or report produced by this package.
+// Implementations decide where the content ends up: a browser window, a file,
+// an HTTP server, or an arbitrary io.Writer.
+type Renderer interface {
+	Render(html *HTML) error
+}
+
+// defaultRenderer is used by HTML.Show. It is auto-detected on package init,
+// falling back to HTTPRenderer in headless environments, and can be overridden
+// with SetDefaultRenderer.
+var defaultRenderer Renderer = detectDefaultRenderer()
+
+// SetDefaultRenderer overrides the Renderer used by HTML.Show.
+//
+// Parameters:
+//   - r: The Renderer to use for subsequent Show calls.
+//
+// Example:
+//   finalytics.SetDefaultRenderer(finalytics.WriterRenderer{Writer: os.Stdout})
+func SetDefaultRenderer(r Renderer) {
+	defaultRenderer = r
+}
+
+// BrowserRenderer writes the HTML to a temporary file and opens it with the
+// operating system's default browser ("open" on macOS, "rundll32" on Windows,
+// "xdg-open" elsewhere). This is the original behavior of HTML.Show.
+type BrowserRenderer struct{}
+
+// Render writes html to a temp file and opens it in the default browser.
+func (BrowserRenderer) Render(html *HTML) error {
+	tmpFile, err := os.CreateTemp("", "chart-*.html")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer tmpFile.Close()
+
+	if _, err := tmpFile.WriteString(html.Content); err != nil {
+		return fmt.Errorf("failed to write HTML to temp file: %w", err)
+	}
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", tmpFile.Name())
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", tmpFile.Name())
+	default: // linux, freebsd, etc.
+		cmd = exec.Command("xdg-open", tmpFile.Name())
+	}
+	return cmd.Start()
+}
+
+// FileRenderer writes the HTML to a caller-chosen path on disk.
+type FileRenderer struct {
+	Path string
+}
+
+// Render writes html.Content to r.Path, creating or truncating the file.
+func (r FileRenderer) Render(html *HTML) error {
+	return os.WriteFile(r.Path, []byte(html.Content), 0o644)
+}
+
+// WriterRenderer streams the HTML to an arbitrary io.Writer, e.g. os.Stdout
+// or an embedding application's own output stream.
+type WriterRenderer struct {
+	Writer io.Writer
+}
+
+// Render writes html.Content to r.Writer.
+func (r WriterRenderer) Render(html *HTML) error {
+	_, err := io.WriteString(r.Writer, html.Content)
+	return err
+}
+
+// HTTPRenderer starts an ephemeral net/http server on Addr (or a random free
+// port if Addr is empty) that serves the HTML, then prints the URL to serve it
+// at. It blocks until the process exits, making it suitable for headless
+// environments such as containers, CI, and WSL where no browser is available.
+type HTTPRenderer struct {
+	Addr string
+}
+
+// Render starts an HTTP server serving html.Content and prints its URL.
+func (r HTTPRenderer) Render(html *HTML) error {
+	addr := r.Addr
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to start HTTP renderer: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = io.WriteString(w, html.Content)
+	})
+
+	fmt.Printf("Serving chart at http://%s/\n", listener.Addr().String())
+	return http.Serve(listener, mux)
+}
+
+// ServeHTTP implements http.Handler, so an HTML chart can be mounted
+// directly as a route (e.g. mux.Handle("/chart", &chart)) instead of only
+// being opened standalone via Show.
+func (c *HTML) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = io.WriteString(w, c.Content)
+}
+
+// headlessBrowserPath locates a headless Chromium/Chrome binary on PATH,
+// used by SavePNG and SavePDF to rasterize the chart's JS-rendered output.
+// There is no pure-Go renderer for arbitrary Plotly HTML, so these two
+// formats require one; SaveSVG does not and works without it.
+func headlessBrowserPath() (string, error) {
+	for _, name := range []string{"chromium", "chromium-browser", "google-chrome", "google-chrome-stable"} {
+		if path, err := exec.LookPath(name); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no headless chromium/chrome binary found on PATH; install one of chromium, google-chrome to use SavePNG/SavePDF")
+}
+
+// SavePNG rasterizes the chart to a PNG at path using a headless
+// Chromium/Chrome binary found on PATH, at scale times the chart's native
+// resolution (1 for no scaling).
+//
+// Parameters:
+//   - path: The destination PNG file path.
+//   - scale: The device scale factor to rasterize at (1 for native resolution).
+//
+// Returns:
+//   - error: An error if no headless browser is available, or rasterization fails.
+//
+// Example:
+//   err := chart.SavePNG("chart.png", 2)
+func (c *HTML) SavePNG(path string, scale float64) error {
+	browser, err := headlessBrowserPath()
+	if err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp("", "chart-*.html")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.WriteString(c.Content); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to write HTML to temp file: %w", err)
+	}
+	tmpFile.Close()
+
+	if scale <= 0 {
+		scale = 1
+	}
+	cmd := exec.Command(browser,
+		"--headless", "--disable-gpu",
+		fmt.Sprintf("--screenshot=%s", path),
+		fmt.Sprintf("--force-device-scale-factor=%g", scale),
+		"file://"+tmpFile.Name(),
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to rasterize chart to PNG: %w (%s)", err, out)
+	}
+	return nil
+}
+
+// SavePDF renders the chart to a PDF at path using a headless
+// Chromium/Chrome binary found on PATH.
+//
+// Parameters:
+//   - path: The destination PDF file path.
+//
+// Returns:
+//   - error: An error if no headless browser is available, or rendering fails.
+//
+// Example:
+//   err := chart.SavePDF("chart.pdf")
+func (c *HTML) SavePDF(path string) error {
+	browser, err := headlessBrowserPath()
+	if err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp("", "chart-*.html")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.WriteString(c.Content); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to write HTML to temp file: %w", err)
+	}
+	tmpFile.Close()
+
+	cmd := exec.Command(browser,
+		"--headless", "--disable-gpu",
+		fmt.Sprintf("--print-to-pdf=%s", path),
+		"file://"+tmpFile.Name(),
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to render chart to PDF: %w (%s)", err, out)
+	}
+	return nil
+}
+
+// SaveSVG wraps the chart's HTML inside a standalone SVG document via
+// <foreignObject>, so it can be saved as .svg without a headless browser
+// (unlike SavePNG/SavePDF). This is a pure-Go path for CI environments
+// without chromium; the result embeds the full interactive chart rather
+// than rasterizing it, so it only renders correctly in SVG viewers that
+// support HTML-embedding foreignObject (browsers do; some SVG toolchains don't).
+//
+// Parameters:
+//   - path: The destination SVG file path.
+//
+// Returns:
+//   - error: An error if the file could not be written.
+//
+// Example:
+//   err := chart.SaveSVG("chart.svg")
+func (c *HTML) SaveSVG(path string) error {
+	svg := fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xhtml="http://www.w3.org/1999/xhtml" width="100%%" height="100%%">
+<foreignObject width="100%%" height="100%%">
+<xhtml:div xmlns="http://www.w3.org/1999/xhtml">%s</xhtml:div>
+</foreignObject>
+</svg>
+`, c.Content)
+	return os.WriteFile(path, []byte(svg), 0o644)
+}
+
+// detectDefaultRenderer picks BrowserRenderer unless the environment looks
+// headless, in which case it falls back to HTTPRenderer so Show still works.
+// macOS and Windows always get BrowserRenderer; elsewhere the environment
+// counts as headless when $DISPLAY is empty and no "xdg-open" is on PATH.
+func detectDefaultRenderer() Renderer {
+	switch runtime.GOOS {
+	case "darwin", "windows":
+		return BrowserRenderer{}
+	default:
+		if os.Getenv("DISPLAY") != "" {
+			return BrowserRenderer{}
+		}
+		if _, err := exec.LookPath("xdg-open"); err == nil {
+			return BrowserRenderer{}
+		}
+		return HTTPRenderer{}
+	}
+}
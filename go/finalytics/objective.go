@@ -0,0 +1,50 @@
+package finalytics
+
+// Objective identifies the optimization goal used by Tickers.Optimize and
+// PortfolioBuilder.ObjectiveFunction, mirroring the objective strings accepted
+// by the underlying optimizer.
+type Objective string
+
+const (
+	// ObjectiveMaxSharpe maximizes the portfolio's Sharpe ratio.
+	ObjectiveMaxSharpe Objective = "max_sharpe"
+	// ObjectiveMaxSortino maximizes the portfolio's Sortino ratio, which
+	// penalizes downside deviation below a minimum acceptable return (MAR)
+	// rather than total volatility.
+	ObjectiveMaxSortino Objective = "max_sortino"
+	// ObjectiveMaxCalmar maximizes the portfolio's Calmar ratio: annualized
+	// return divided by maximum drawdown.
+	ObjectiveMaxCalmar Objective = "max_calmar"
+	// ObjectiveMaxOmega maximizes the portfolio's Omega ratio: the
+	// probability-weighted ratio of gains above a threshold to losses below it.
+	ObjectiveMaxOmega Objective = "max_omega"
+	// ObjectiveMaxProfitFactor maximizes the portfolio's profit factor: the
+	// sum of positive period returns divided by the absolute sum of negative
+	// period returns.
+	ObjectiveMaxProfitFactor Objective = "max_profit_factor"
+	// ObjectiveMaxReturn maximizes the portfolio's expected return.
+	ObjectiveMaxReturn Objective = "max_return"
+	// ObjectiveMinVol minimizes the portfolio's volatility.
+	ObjectiveMinVol Objective = "min_vol"
+	// ObjectiveMinVaR minimizes the portfolio's Value-at-Risk.
+	ObjectiveMinVaR Objective = "min_var"
+	// ObjectiveMinCVaR minimizes the portfolio's Conditional Value-at-Risk
+	// (Expected Shortfall): the mean of the worst alpha-tail of returns.
+	ObjectiveMinCVaR Objective = "min_cvar"
+	// ObjectiveMinDrawdown minimizes the portfolio's maximum drawdown.
+	ObjectiveMinDrawdown Objective = "min_drawdown"
+	// ObjectiveRobustMaxSharpe maximizes the portfolio's worst-case Sharpe
+	// ratio over PortfolioBuilder.UncertaintySet's uncertainty set on the
+	// mean return estimate, using PortfolioBuilder.Scenarios in place of the
+	// historical covariance. Solved client-side by
+	// Portfolio.RobustMaxSharpePortfolio rather than through the Rust-backed
+	// optimizer, since it isn't one of that optimizer's supported objectives.
+	ObjectiveRobustMaxSharpe Objective = "robust_max_sharpe"
+	// ObjectiveCVaRMin minimizes the Conditional Value-at-Risk of
+	// PortfolioBuilder.Scenarios' scenario returns at the Portfolio's
+	// configured confidence level, via the Rockafellar-Uryasev LP
+	// reformulation. Solved client-side by Portfolio.CVaRMinPortfolio rather
+	// than through the Rust-backed optimizer; distinct from ObjectiveMinCVaR,
+	// which minimizes CVaR from historical returns through that optimizer.
+	ObjectiveCVaRMin Objective = "cvar_min"
+)
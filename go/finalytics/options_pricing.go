@@ -0,0 +1,282 @@
+package finalytics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// OptionSpec describes a single European option contract to price or solve
+// implied volatility for.
+type OptionSpec struct {
+	Strike     float64
+	Expiration string  // "YYYY-MM-DD"
+	Type       string  // "call" or "put"
+	Volatility float64 // annualized volatility, e.g. 0.25 for 25%; ignored by ImpliedVolatility
+	Spot       float64 // underlying spot price; if 0, fetched via GetQuote's "regularMarketPrice"
+}
+
+// OptionPricingResult is a Black-Scholes-Merton theoretical price and its
+// analytic Greeks for a single option contract.
+type OptionPricingResult struct {
+	Price float64
+	Delta float64
+	Gamma float64
+	Vega  float64 // per 1.00 (100 percentage points) change in volatility
+	Theta float64 // per year; divide by 365 for a per-day figure
+	Rho   float64 // per 1.00 (100 percentage points) change in the risk-free rate
+}
+
+// normPDF is the standard normal probability density function, φ(x).
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt2 / math.Sqrt(math.Pi)
+}
+
+// normCDF is the standard normal cumulative distribution function, N(x).
+func normCDF(x float64) float64 {
+	return 0.5 * (1 + math.Erf(x/math.Sqrt2))
+}
+
+// yearsUntil returns the time from now until expiration (formatted
+// "YYYY-MM-DD"), in years using a 365.25-day calendar year.
+func yearsUntil(expiration string) (float64, error) {
+	expiry, err := time.Parse("2006-01-02", expiration)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse expiration %q: %v", expiration, err)
+	}
+	days := expiry.Sub(time.Now()).Hours() / 24
+	return days / 365.25, nil
+}
+
+// blackScholesMerton prices a European option and its Greeks given spot
+// price s, strike k, continuously-compounded risk-free rate r, dividend
+// yield q, annualized volatility sigma, time to expiry in years t, and
+// whether it is a call.
+func blackScholesMerton(s, k, r, q, sigma, t float64, isCall bool) OptionPricingResult {
+	if t <= 0 || sigma <= 0 || s <= 0 || k <= 0 {
+		return OptionPricingResult{}
+	}
+
+	sqrtT := math.Sqrt(t)
+	d1 := (math.Log(s/k) + (r-q+sigma*sigma/2)*t) / (sigma * sqrtT)
+	d2 := d1 - sigma*sqrtT
+
+	discQ := math.Exp(-q * t)
+	discR := math.Exp(-r * t)
+	pdfD1 := normPDF(d1)
+
+	var price, delta, theta, rho float64
+	if isCall {
+		price = s*discQ*normCDF(d1) - k*discR*normCDF(d2)
+		delta = discQ * normCDF(d1)
+		theta = -s*discQ*pdfD1*sigma/(2*sqrtT) - r*k*discR*normCDF(d2) + q*s*discQ*normCDF(d1)
+		rho = k * t * discR * normCDF(d2)
+	} else {
+		price = k*discR*normCDF(-d2) - s*discQ*normCDF(-d1)
+		delta = -discQ * normCDF(-d1)
+		theta = -s*discQ*pdfD1*sigma/(2*sqrtT) + r*k*discR*normCDF(-d2) - q*s*discQ*normCDF(-d1)
+		rho = -k * t * discR * normCDF(-d2)
+	}
+	gamma := discQ * pdfD1 / (s * sigma * sqrtT)
+	vega := s * discQ * pdfD1 * sqrtT
+
+	return OptionPricingResult{Price: price, Delta: delta, Gamma: gamma, Vega: vega, Theta: theta, Rho: rho}
+}
+
+// spotFor returns spec.Spot if set, otherwise the ticker's current
+// regularMarketPrice quote.
+func (t *Ticker) spotFor(spec OptionSpec) (float64, error) {
+	if spec.Spot > 0 {
+		return spec.Spot, nil
+	}
+	quote, err := t.GetQuote()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get quote for spot price: %v", err)
+	}
+	spot, ok := quote["regularMarketPrice"].(float64)
+	if !ok {
+		return 0, errors.New("quote did not contain a numeric \"regularMarketPrice\" to use as spot price")
+	}
+	return spot, nil
+}
+
+// PriceOption computes the Black-Scholes-Merton theoretical price and
+// Greeks for spec, using the ticker's riskFreeRate and dividendYield and,
+// if spec.Spot is unset, the ticker's current quote as the spot price.
+//
+// Parameters:
+//   - spec: The OptionSpec describing the contract (strike, expiration,
+//     type, volatility, and optionally spot).
+//
+// Returns:
+//   - OptionPricingResult: The theoretical price and Greeks.
+//   - error: An error if the spot price or time to expiry cannot be determined.
+//
+// Example:
+//   result, err := ticker.PriceOption(finalytics.OptionSpec{
+//   	Strike: 150, Expiration: "2024-06-21", Type: "call", Volatility: 0.25,
+//   })
+func (t *Ticker) PriceOption(spec OptionSpec) (OptionPricingResult, error) {
+	spot, err := t.spotFor(spec)
+	if err != nil {
+		return OptionPricingResult{}, err
+	}
+	years, err := yearsUntil(spec.Expiration)
+	if err != nil {
+		return OptionPricingResult{}, err
+	}
+	isCall := spec.Type != "put"
+	return blackScholesMerton(spot, spec.Strike, t.riskFreeRate, t.dividendYield, spec.Volatility, years, isCall), nil
+}
+
+// ImpliedVolatility solves for the annualized volatility that reprices spec
+// to marketPrice under Black-Scholes-Merton. It tries Newton-Raphson first
+// (fast, using the analytic vega), falling back to bisection on
+// sigma in (1e-6, 5) if vega is too small or Newton-Raphson diverges.
+//
+// Parameters:
+//   - spec: The OptionSpec describing the contract (spec.Volatility is ignored).
+//   - marketPrice: The observed market price (e.g. the contract's mid-price) to match.
+//
+// Returns:
+//   - float64: The implied annualized volatility.
+//   - error: An error if the spot price or time to expiry cannot be
+//     determined, or no volatility in (1e-6, 5) reprices the option to
+//     marketPrice.
+//
+// Example:
+//   iv, err := ticker.ImpliedVolatility(finalytics.OptionSpec{
+//   	Strike: 150, Expiration: "2024-06-21", Type: "call",
+//   }, 12.35)
+func (t *Ticker) ImpliedVolatility(spec OptionSpec, marketPrice float64) (float64, error) {
+	spot, err := t.spotFor(spec)
+	if err != nil {
+		return 0, err
+	}
+	years, err := yearsUntil(spec.Expiration)
+	if err != nil {
+		return 0, err
+	}
+	isCall := spec.Type != "put"
+
+	const (
+		minVol      = 1e-6
+		maxVol      = 5.0
+		minVega     = 1e-8
+		tolerance   = 1e-8
+		maxNewton   = 50
+		maxBisect   = 200
+	)
+
+	priceAt := func(sigma float64) OptionPricingResult {
+		return blackScholesMerton(spot, spec.Strike, t.riskFreeRate, t.dividendYield, sigma, years, isCall)
+	}
+
+	// Newton-Raphson fast path, starting from a conventional 0.2 (20%) guess.
+	sigma := 0.2
+	for i := 0; i < maxNewton; i++ {
+		result := priceAt(sigma)
+		diff := result.Price - marketPrice
+		if math.Abs(diff) < tolerance {
+			return sigma, nil
+		}
+		if math.Abs(result.Vega) < minVega {
+			break
+		}
+		next := sigma - diff/result.Vega
+		if next <= minVol || next >= maxVol || math.IsNaN(next) {
+			break // diverged out of bounds; fall back to bisection
+		}
+		sigma = next
+	}
+
+	// Bisection fallback on (minVol, maxVol).
+	lo, hi := minVol, maxVol
+	fLo := priceAt(lo).Price - marketPrice
+	fHi := priceAt(hi).Price - marketPrice
+	if fLo == 0 {
+		return lo, nil
+	}
+	if fHi == 0 {
+		return hi, nil
+	}
+	if (fLo > 0) == (fHi > 0) {
+		return 0, fmt.Errorf("no volatility in (%.0e, %.0f) reprices the option to %.4f", minVol, maxVol, marketPrice)
+	}
+	for i := 0; i < maxBisect; i++ {
+		mid := (lo + hi) / 2
+		fMid := priceAt(mid).Price - marketPrice
+		if math.Abs(fMid) < tolerance {
+			return mid, nil
+		}
+		if (fMid > 0) == (fLo > 0) {
+			lo, fLo = mid, fMid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2, nil
+}
+
+// OptionGreeks computes the Black-Scholes-Merton price and Greeks for every
+// contract in the ticker's options chain, using each contract's own strike,
+// expiration, type, and implied volatility from GetOptionsChain. A contract
+// whose expiration has already passed, or whose implied volatility is
+// missing or non-positive, gets zero-valued price and Greeks columns rather
+// than failing the whole call.
+//
+// Returns:
+//   - dataframe.DataFrame: The options chain with "price", "delta", "gamma",
+//     "vega", "theta", and "rho" columns appended.
+//   - error: An error if the options chain or current quote cannot be retrieved.
+//
+// Example:
+//   greeks, err := ticker.OptionGreeks()
+func (t *Ticker) OptionGreeks() (dataframe.DataFrame, error) {
+	chain, err := t.GetOptionsChain()
+	if err != nil {
+		return dataframe.DataFrame{}, fmt.Errorf("failed to get options chain: %v", err)
+	}
+	spot, err := t.spotFor(OptionSpec{})
+	if err != nil {
+		return dataframe.DataFrame{}, err
+	}
+
+	n := chain.Nrow()
+	prices := make([]float64, n)
+	deltas := make([]float64, n)
+	gammas := make([]float64, n)
+	vegas := make([]float64, n)
+	thetas := make([]float64, n)
+	rhos := make([]float64, n)
+
+	for row := 0; row < n; row++ {
+		strike := floatCellOrZero(chain, "strike", row)
+		expiration := stringCellOrEmpty(chain, "expiration", row)
+		optionType := stringCellOrEmpty(chain, "type", row)
+		iv := floatCellOrZero(chain, "impliedVolatility", row)
+
+		years, err := yearsUntil(expiration)
+		if err != nil || years <= 0 || iv <= 0 {
+			continue
+		}
+		result := blackScholesMerton(spot, strike, t.riskFreeRate, t.dividendYield, iv, years, optionType != "put")
+		prices[row] = result.Price
+		deltas[row] = result.Delta
+		gammas[row] = result.Gamma
+		vegas[row] = result.Vega
+		thetas[row] = result.Theta
+		rhos[row] = result.Rho
+	}
+
+	return chain.Mutate(series.New(prices, series.Float, "price")).
+		Mutate(series.New(deltas, series.Float, "delta")).
+		Mutate(series.New(gammas, series.Float, "gamma")).
+		Mutate(series.New(vegas, series.Float, "vega")).
+		Mutate(series.New(thetas, series.Float, "theta")).
+		Mutate(series.New(rhos, series.Float, "rho")), nil
+}
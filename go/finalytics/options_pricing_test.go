@@ -0,0 +1,76 @@
+package finalytics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormCDF(t *testing.T) {
+	cases := []struct {
+		x    float64
+		want float64
+	}{
+		{0, 0.5},
+		{1.96, 0.9750021},
+		{-1.96, 0.0249979},
+	}
+	for _, c := range cases {
+		if got := normCDF(c.x); math.Abs(got-c.want) > 1e-6 {
+			t.Errorf("normCDF(%v) = %v, want %v", c.x, got, c.want)
+		}
+	}
+}
+
+func TestBlackScholesMertonPutCallParity(t *testing.T) {
+	s, k, r, q, sigma, ti := 100.0, 100.0, 0.05, 0.0, 0.2, 1.0
+	call := blackScholesMerton(s, k, r, q, sigma, ti, true)
+	put := blackScholesMerton(s, k, r, q, sigma, ti, false)
+
+	// Put-call parity: C - P = S*e^(-qT) - K*e^(-rT).
+	want := s*math.Exp(-q*ti) - k*math.Exp(-r*ti)
+	if got := call.Price - put.Price; math.Abs(got-want) > 1e-6 {
+		t.Errorf("call.Price - put.Price = %v, want %v", got, want)
+	}
+}
+
+func TestBlackScholesMertonInvalidInputs(t *testing.T) {
+	cases := []struct {
+		name                  string
+		s, k, r, q, sigma, ti float64
+	}{
+		{"zero time", 100, 100, 0.05, 0, 0.2, 0},
+		{"negative time", 100, 100, 0.05, 0, 0.2, -1},
+		{"zero volatility", 100, 100, 0.05, 0, 0, 1},
+		{"zero spot", 0, 100, 0.05, 0, 0.2, 1},
+		{"zero strike", 100, 0, 0.05, 0, 0.2, 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := blackScholesMerton(c.s, c.k, c.r, c.q, c.sigma, c.ti, true)
+			if got != (OptionPricingResult{}) {
+				t.Errorf("blackScholesMerton(%+v) = %+v, want zero value", c, got)
+			}
+		})
+	}
+}
+
+func TestBlackScholesMertonGreeksSanity(t *testing.T) {
+	// A deep in-the-money call should have delta close to 1 and gamma close to 0.
+	itm := blackScholesMerton(200, 100, 0.05, 0, 0.2, 1, true)
+	if itm.Delta < 0.95 {
+		t.Errorf("deep ITM call delta = %v, want close to 1", itm.Delta)
+	}
+	if itm.Gamma > 0.01 {
+		t.Errorf("deep ITM call gamma = %v, want close to 0", itm.Gamma)
+	}
+
+	// Vega must be positive and identical for a call and put at the same strike/expiry.
+	call := blackScholesMerton(100, 100, 0.05, 0, 0.2, 1, true)
+	put := blackScholesMerton(100, 100, 0.05, 0, 0.2, 1, false)
+	if call.Vega <= 0 {
+		t.Errorf("call.Vega = %v, want > 0", call.Vega)
+	}
+	if math.Abs(call.Vega-put.Vega) > 1e-9 {
+		t.Errorf("call.Vega = %v, put.Vega = %v, want equal", call.Vega, put.Vega)
+	}
+}
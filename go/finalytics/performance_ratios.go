@@ -0,0 +1,341 @@
+package finalytics
+
+import (
+	"math"
+	"sort"
+)
+
+// maxDrawdown returns the largest peak-to-trough decline observed in equity,
+// expressed as a positive fraction (e.g. 0.25 for a 25% drawdown).
+func maxDrawdown(equity []float64) float64 {
+	if len(equity) == 0 {
+		return 0
+	}
+	peak := equity[0]
+	maxDD := 0.0
+	for _, v := range equity {
+		if v > peak {
+			peak = v
+		}
+		if peak > 0 {
+			if dd := (peak - v) / peak; dd > maxDD {
+				maxDD = dd
+			}
+		}
+	}
+	return maxDD
+}
+
+// ulcerIndex returns the square root of the mean squared drawdown across equity,
+// a measure of downside volatility that penalizes both the depth and duration of drawdowns.
+func ulcerIndex(equity []float64) float64 {
+	if len(equity) == 0 {
+		return 0
+	}
+	peak := equity[0]
+	sumSq := 0.0
+	for _, v := range equity {
+		if v > peak {
+			peak = v
+		}
+		if peak > 0 {
+			ddPct := (peak - v) / peak * 100
+			sumSq += ddPct * ddPct
+		}
+	}
+	return math.Sqrt(sumSq / float64(len(equity)))
+}
+
+// omegaRatio returns the ratio of the probability-weighted gains to losses in
+// returns relative to threshold: sum(r - threshold for returns above threshold) / sum(threshold - r for returns at or below threshold).
+func omegaRatio(returns []float64, threshold float64) float64 {
+	gains, losses := 0.0, 0.0
+	for _, r := range returns {
+		if r > threshold {
+			gains += r - threshold
+		} else {
+			losses += threshold - r
+		}
+	}
+	if losses == 0 {
+		return 0
+	}
+	return gains / losses
+}
+
+// annualizedReturn compounds a periodic return series up to an annual rate,
+// assuming periodsPerYear periods per year (e.g. 252 for daily data).
+func annualizedReturn(returns []float64, periodsPerYear float64) float64 {
+	if len(returns) == 0 {
+		return 0
+	}
+	cumulative := 1.0
+	for _, r := range returns {
+		cumulative *= 1 + r
+	}
+	years := float64(len(returns)) / periodsPerYear
+	if years <= 0 {
+		return 0
+	}
+	return math.Pow(cumulative, 1/years) - 1
+}
+
+// calmarRatio returns the ratio of annualized return to maximum drawdown.
+func calmarRatio(annualReturn, maxDD float64) float64 {
+	if maxDD == 0 {
+		return 0
+	}
+	return annualReturn / maxDD
+}
+
+// annualPeriods returns the number of bars per year for a given data interval,
+// used to annualize returns (e.g. "1d" has 252 trading days per year).
+func annualPeriods(interval string) float64 {
+	switch interval {
+	case "1wk":
+		return 52
+	case "1mo":
+		return 12
+	case "3mo":
+		return 4
+	default: // "1d" and intraday intervals are treated as daily bars
+		return 252
+	}
+}
+
+// marRatio returns the MAR ratio: compound annual growth rate divided by
+// maximum drawdown, computed directly from an equity curve.
+func marRatio(equity []float64, periodsPerYear float64) float64 {
+	if len(equity) < 2 {
+		return 0
+	}
+	years := float64(len(equity)) / periodsPerYear
+	if years <= 0 || equity[0] <= 0 {
+		return 0
+	}
+	cagr := math.Pow(equity[len(equity)-1]/equity[0], 1/years) - 1
+	maxDD := maxDrawdown(equity)
+	if maxDD == 0 {
+		return 0
+	}
+	return cagr / maxDD
+}
+
+// sortinoRatio returns the mean return in excess of mar (the minimum
+// acceptable return) divided by the downside deviation: the root-mean-square
+// of returns that fall short of mar.
+func sortinoRatio(returns []float64, mar float64) float64 {
+	if len(returns) == 0 {
+		return 0
+	}
+	sum := 0.0
+	sumSqDownside := 0.0
+	for _, r := range returns {
+		sum += r - mar
+		if r < mar {
+			d := r - mar
+			sumSqDownside += d * d
+		}
+	}
+	downsideDeviation := math.Sqrt(sumSqDownside / float64(len(returns)))
+	if downsideDeviation == 0 {
+		return 0
+	}
+	return (sum / float64(len(returns))) / downsideDeviation
+}
+
+// equityFromReturns converts a slice of periodic returns into a cumulative
+// equity curve compounded from a base of 1.0, one point per return (the 1.0
+// base itself is not included).
+func equityFromReturns(returns []float64) []float64 {
+	equity := make([]float64, len(returns))
+	cumulative := 1.0
+	for i, r := range returns {
+		cumulative *= 1 + r
+		equity[i] = cumulative
+	}
+	return equity
+}
+
+// maxDrawdownDuration returns the length, in periods, of the longest
+// underwater streak in equity: the most periods elapsed between a peak and
+// the point equity next recovers to or past that peak. A streak still
+// underway at the end of equity counts toward the total.
+func maxDrawdownDuration(equity []float64) int {
+	if len(equity) == 0 {
+		return 0
+	}
+	peak := equity[0]
+	longest, current := 0, 0
+	for _, v := range equity {
+		if v >= peak {
+			peak = v
+			current = 0
+			continue
+		}
+		current++
+		if current > longest {
+			longest = current
+		}
+	}
+	return longest
+}
+
+// drawdownSeries returns, for every point in equity, the peak-to-date decline
+// expressed as a positive fraction (e.g. 0.1 for 10% under the running peak),
+// the pointwise counterpart to maxDrawdown's single worst value.
+func drawdownSeries(equity []float64) []float64 {
+	dd := make([]float64, len(equity))
+	if len(equity) == 0 {
+		return dd
+	}
+	peak := equity[0]
+	for i, v := range equity {
+		if v > peak {
+			peak = v
+		}
+		if peak > 0 {
+			dd[i] = (peak - v) / peak
+		}
+	}
+	return dd
+}
+
+// cvar returns the Conditional Value-at-Risk (Expected Shortfall) of returns
+// at confidence level alpha (e.g. 0.95): the mean loss over the worst
+// (1-alpha) fraction of periods, expressed as a positive fraction.
+func cvar(returns []float64, alpha float64) float64 {
+	n := len(returns)
+	if n == 0 {
+		return 0
+	}
+	sorted := make([]float64, n)
+	copy(sorted, returns)
+	sort.Float64s(sorted)
+
+	tailSize := int(math.Ceil((1 - alpha) * float64(n)))
+	if tailSize < 1 {
+		tailSize = 1
+	}
+	if tailSize > n {
+		tailSize = n
+	}
+
+	sum := 0.0
+	for _, r := range sorted[:tailSize] {
+		sum += r
+	}
+	return -(sum / float64(tailSize))
+}
+
+// valueAtRisk returns the historical Value-at-Risk of returns at confidence
+// level alpha (e.g. 0.95): the loss at the (1-alpha) percentile, expressed as
+// a positive fraction.
+func valueAtRisk(returns []float64, alpha float64) float64 {
+	n := len(returns)
+	if n == 0 {
+		return 0
+	}
+	sorted := make([]float64, n)
+	copy(sorted, returns)
+	sort.Float64s(sorted)
+
+	idx := int(math.Floor((1 - alpha) * float64(n)))
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= n {
+		idx = n - 1
+	}
+	return -sorted[idx]
+}
+
+// sharpeRatio returns the mean periodic return in excess of a periodic risk-
+// free rate (annualRiskFreeRate / periodsPerYear), divided by the standard
+// deviation of returns.
+func sharpeRatio(returns []float64, annualRiskFreeRate, periodsPerYear float64) float64 {
+	if len(returns) == 0 {
+		return 0
+	}
+	periodicRiskFree := annualRiskFreeRate / periodsPerYear
+	mean, sd := meanAndStddev(returns)
+	if sd == 0 {
+		return 0
+	}
+	return (mean - periodicRiskFree) / sd
+}
+
+// meanAndStddev returns the sample mean and population standard deviation of values.
+func meanAndStddev(values []float64) (mean, stddev float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+	var sumSq float64
+	for _, v := range values {
+		d := v - mean
+		sumSq += d * d
+	}
+	return mean, math.Sqrt(sumSq / float64(len(values)))
+}
+
+// beta returns the sensitivity of returns to benchmarkReturns (both the same
+// length): the covariance of the two series divided by the variance of
+// benchmarkReturns.
+func beta(returns, benchmarkReturns []float64) float64 {
+	if len(returns) == 0 || len(returns) != len(benchmarkReturns) {
+		return 0
+	}
+	meanR, _ := meanAndStddev(returns)
+	meanB, _ := meanAndStddev(benchmarkReturns)
+	var cov, varB float64
+	for i := range returns {
+		dr := returns[i] - meanR
+		db := benchmarkReturns[i] - meanB
+		cov += dr * db
+		varB += db * db
+	}
+	if varB == 0 {
+		return 0
+	}
+	return cov / varB
+}
+
+// alpha returns the mean return of returns in excess of what seriesBeta
+// applied to benchmarkReturns would predict.
+func alpha(returns, benchmarkReturns []float64, seriesBeta float64) float64 {
+	meanR, _ := meanAndStddev(returns)
+	meanB, _ := meanAndStddev(benchmarkReturns)
+	return meanR - seriesBeta*meanB
+}
+
+// trackingError returns the standard deviation of the period-by-period
+// difference between returns and benchmarkReturns.
+func trackingError(returns, benchmarkReturns []float64) float64 {
+	if len(returns) == 0 || len(returns) != len(benchmarkReturns) {
+		return 0
+	}
+	diffs := make([]float64, len(returns))
+	for i := range returns {
+		diffs[i] = returns[i] - benchmarkReturns[i]
+	}
+	_, sd := meanAndStddev(diffs)
+	return sd
+}
+
+// informationRatio returns the mean excess return of returns over
+// benchmarkReturns divided by the tracking error between them.
+func informationRatio(returns, benchmarkReturns []float64, trackingErr float64) float64 {
+	if trackingErr == 0 {
+		return 0
+	}
+	diffs := make([]float64, len(returns))
+	for i := range returns {
+		diffs[i] = returns[i] - benchmarkReturns[i]
+	}
+	mean, _ := meanAndStddev(diffs)
+	return mean / trackingErr
+}
@@ -0,0 +1,109 @@
+package finalytics
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestMaxDrawdown(t *testing.T) {
+	cases := []struct {
+		name   string
+		equity []float64
+		want   float64
+	}{
+		{"empty", nil, 0},
+		{"monotonic up", []float64{100, 110, 120}, 0},
+		{"single drawdown", []float64{100, 120, 90, 110}, 0.25},
+		{"recovers then drops further", []float64{100, 50, 80, 20}, 0.8},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := maxDrawdown(c.equity); !almostEqual(got, c.want) {
+				t.Errorf("maxDrawdown(%v) = %v, want %v", c.equity, got, c.want)
+			}
+		})
+	}
+}
+
+func TestUlcerIndex(t *testing.T) {
+	if got := ulcerIndex(nil); got != 0 {
+		t.Errorf("ulcerIndex(nil) = %v, want 0", got)
+	}
+	if got := ulcerIndex([]float64{100, 110, 120}); got != 0 {
+		t.Errorf("ulcerIndex(monotonic up) = %v, want 0", got)
+	}
+	// Single 50% drawdown bar out of two: sqrt((0^2 + 50^2) / 2).
+	want := math.Sqrt((0*0 + 50*50) / 2.0)
+	if got := ulcerIndex([]float64{100, 50}); !almostEqual(got, want) {
+		t.Errorf("ulcerIndex([100, 50]) = %v, want %v", got, want)
+	}
+}
+
+func TestOmegaRatio(t *testing.T) {
+	// Gains of 0.01 and 0.03 above threshold 0, loss of 0.02 below it.
+	returns := []float64{0.01, -0.02, 0.03}
+	want := (0.01 + 0.03) / 0.02
+	if got := omegaRatio(returns, 0); !almostEqual(got, want) {
+		t.Errorf("omegaRatio(%v, 0) = %v, want %v", returns, got, want)
+	}
+	if got := omegaRatio([]float64{0.01, 0.02}, 0); got != 0 {
+		t.Errorf("omegaRatio with no losses = %v, want 0", got)
+	}
+}
+
+func TestAnnualizedReturn(t *testing.T) {
+	if got := annualizedReturn(nil, 252); got != 0 {
+		t.Errorf("annualizedReturn(nil, 252) = %v, want 0", got)
+	}
+	// 252 periods of 0.01 compounds to (1.01)^252 - 1 over exactly one year.
+	returns := make([]float64, 252)
+	for i := range returns {
+		returns[i] = 0.01
+	}
+	want := math.Pow(1.01, 252) - 1
+	if got := annualizedReturn(returns, 252); !almostEqual(got, want) {
+		t.Errorf("annualizedReturn(252x0.01, 252) = %v, want %v", got, want)
+	}
+}
+
+func TestCalmarRatio(t *testing.T) {
+	if got := calmarRatio(0.2, 0); got != 0 {
+		t.Errorf("calmarRatio(0.2, 0) = %v, want 0", got)
+	}
+	if got := calmarRatio(0.2, 0.1); !almostEqual(got, 2) {
+		t.Errorf("calmarRatio(0.2, 0.1) = %v, want 2", got)
+	}
+}
+
+func TestAnnualPeriods(t *testing.T) {
+	cases := map[string]float64{
+		"1wk": 52,
+		"1mo": 12,
+		"3mo": 4,
+		"1d":  252,
+		"1h":  252,
+		"":    252,
+	}
+	for interval, want := range cases {
+		if got := annualPeriods(interval); got != want {
+			t.Errorf("annualPeriods(%q) = %v, want %v", interval, got, want)
+		}
+	}
+}
+
+func TestMarRatio(t *testing.T) {
+	if got := marRatio([]float64{100}, 252); got != 0 {
+		t.Errorf("marRatio(single point) = %v, want 0", got)
+	}
+	equity := []float64{100, 120, 90, 150}
+	years := float64(len(equity)) / 252
+	cagr := math.Pow(equity[len(equity)-1]/equity[0], 1/years) - 1
+	want := cagr / maxDrawdown(equity)
+	if got := marRatio(equity, 252); !almostEqual(got, want) {
+		t.Errorf("marRatio(%v, 252) = %v, want %v", equity, got, want)
+	}
+}
@@ -8,6 +8,7 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"unsafe"
 
 	"github.com/go-gota/gota/dataframe"
@@ -16,7 +17,30 @@ import (
 // Portfolio represents a portfolio of assets with methods for retrieving optimization results and analytics.
 // It encapsulates a handle to the underlying C library for interacting with portfolio data.
 type Portfolio struct {
-	handle C.PortfolioHandle
+	handle            C.PortfolioHandle
+	objective         Objective
+	interval          string
+	confidenceLevel   float64
+	startDate         string
+	endDate           string
+	symbols           []string
+	equalityA         [][]float64
+	equalityB         []float64
+	inequalityC       [][]float64
+	inequalityLower   []float64
+	inequalityUpper   []float64
+	weightLower       []float64
+	weightUpper       []float64
+	scenarios         [][]float64
+	uncertaintyKind   string
+	uncertaintyParams map[string]float64
+
+	// liveMu guards returnSeries/lastCloses, which Update/UpdateBatch extend
+	// incrementally and CurrentWeights/Rebalance (via the QP-based optimizer
+	// methods) read, without rebuilding handle.
+	liveMu       sync.RWMutex
+	returnSeries [][]float64
+	lastCloses   []float64
 }
 
 // PortfolioBuilder is used to construct a Portfolio instance using the builder pattern.
@@ -29,12 +53,22 @@ type PortfolioBuilder struct {
 	interval               string
 	confidenceLevel        float64
 	riskFreeRate           float64
-	objectiveFunction      string
+	objectiveFunction      Objective
 	assetConstraints       string
 	categoricalConstraints string
 	weights                string
 	tickersData            []dataframe.DataFrame
 	benchmarkData          *dataframe.DataFrame
+	equalityA              [][]float64
+	equalityB              []float64
+	inequalityC            [][]float64
+	inequalityLower        []float64
+	inequalityUpper        []float64
+	weightLower            []float64
+	weightUpper            []float64
+	scenarios              [][]float64
+	uncertaintyKind        string
+	uncertaintyParams      map[string]float64
 }
 
 // NewPortfolioBuilder initializes a new PortfolioBuilder with default values.
@@ -78,7 +112,7 @@ func NewPortfolioBuilder() *PortfolioBuilder {
 		interval:               "1d",
 		confidenceLevel:        0.95,
 		riskFreeRate:           0.02,
-		objectiveFunction:      "max_sharpe",
+		objectiveFunction:      ObjectiveMaxSharpe,
 		assetConstraints:       "{}",
 		categoricalConstraints: "{}",
 		weights:                "{}",
@@ -202,15 +236,15 @@ func (b *PortfolioBuilder) RiskFreeRate(riskFreeRate float64) *PortfolioBuilder
 // ObjectiveFunction sets the objective function for optimization.
 //
 // Parameters:
-//   - objectiveFunction: The objective function (e.g., "max_sharpe", "max_sortino", "max_return", "min_vol", "min_var", "min_cvar", "min_drawdown").
+//   - objectiveFunction: The typed Objective to optimize for (e.g., finalytics.ObjectiveMaxSortino).
 //
 // Returns:
 //   - *PortfolioBuilder: The builder instance for method chaining.
 //
 // Example:
 //
-//	builder := finalytics.NewPortfolioBuilder().ObjectiveFunction("max_sharpe")
-func (b *PortfolioBuilder) ObjectiveFunction(objectiveFunction string) *PortfolioBuilder {
+//	builder := finalytics.NewPortfolioBuilder().ObjectiveFunction(finalytics.ObjectiveMaxSharpe)
+func (b *PortfolioBuilder) ObjectiveFunction(objectiveFunction Objective) *PortfolioBuilder {
 	b.objectiveFunction = objectiveFunction
 	return b
 }
@@ -295,6 +329,135 @@ func (b *PortfolioBuilder) BenchmarkData(benchmarkData *dataframe.DataFrame) *Po
 	return b
 }
 
+// EqualityConstraints sets general linear equality constraints Ax = b used
+// by Portfolio.EfficientFrontier, Portfolio.MinVariancePortfolio and
+// Portfolio.TangencyPortfolio, in addition to the sum(weights) = 1
+// constraint they already enforce. A has one row per equation and one
+// column per symbol, in the same order as TickerSymbols (e.g. a row of
+// [1, -1, 0, 0] with b = 0 pins the first two symbols' weights equal,
+// encoding a sector-neutral or pairs constraint the JSON
+// assetConstraints/categoricalConstraints interface can't express).
+//
+// Parameters:
+//   - a: The constraint coefficient matrix (one row per equation).
+//   - b: The right-hand side of each equation.
+//
+// Returns:
+//   - *PortfolioBuilder: The builder instance for method chaining.
+//
+// Example:
+//
+//	// Force the first two symbols to have equal weight.
+//	builder := finalytics.NewPortfolioBuilder().
+//		TickerSymbols([]string{"AAPL", "MSFT", "NVDA"}).
+//		EqualityConstraints([][]float64{{1, -1, 0}}, []float64{0})
+func (b *PortfolioBuilder) EqualityConstraints(a [][]float64, bb []float64) *PortfolioBuilder {
+	b.equalityA = a
+	b.equalityB = bb
+	return b
+}
+
+// InequalityConstraints sets general linear inequality constraints
+// clo <= Cx <= cup used by Portfolio.EfficientFrontier,
+// Portfolio.MinVariancePortfolio and Portfolio.TangencyPortfolio. C has one
+// row per inequality and one column per symbol, in the same order as
+// TickerSymbols. Leverage caps, group/sector budgets and turnover limits
+// (via slack variables appended as extra symbols) are all expressible this
+// way.
+//
+// Parameters:
+//   - c: The constraint coefficient matrix (one row per inequality).
+//   - clo: The lower bound of each row's Cx.
+//   - cup: The upper bound of each row's Cx.
+//
+// Returns:
+//   - *PortfolioBuilder: The builder instance for method chaining.
+//
+// Example:
+//
+//	// Cap combined weight of the first two symbols at 60%.
+//	builder := finalytics.NewPortfolioBuilder().
+//		TickerSymbols([]string{"AAPL", "MSFT", "NVDA"}).
+//		InequalityConstraints([][]float64{{1, 1, 0}}, []float64{0}, []float64{0.6})
+func (b *PortfolioBuilder) InequalityConstraints(c [][]float64, clo, cup []float64) *PortfolioBuilder {
+	b.inequalityC = c
+	b.inequalityLower = clo
+	b.inequalityUpper = cup
+	return b
+}
+
+// WeightBounds sets the per-symbol xlo <= x <= xup weight bounds used by
+// Portfolio.EfficientFrontier, Portfolio.MinVariancePortfolio and
+// Portfolio.TangencyPortfolio. Symbols without a corresponding entry (or
+// when lower/upper is nil) default to [0, 1]. This is distinct from
+// AssetConstraints, which is passed through as an opaque JSON string to the
+// Rust-backed OptimizationResults solver rather than the client-side QP
+// solver these bounds feed.
+//
+// Parameters:
+//   - lower: The minimum weight for each symbol, in TickerSymbols order.
+//   - upper: The maximum weight for each symbol, in TickerSymbols order.
+//
+// Returns:
+//   - *PortfolioBuilder: The builder instance for method chaining.
+//
+// Example:
+//
+//	builder := finalytics.NewPortfolioBuilder().
+//		TickerSymbols([]string{"AAPL", "MSFT", "NVDA"}).
+//		WeightBounds([]float64{0, 0, 0}, []float64{0.5, 0.5, 0.5})
+func (b *PortfolioBuilder) WeightBounds(lower, upper []float64) *PortfolioBuilder {
+	b.weightLower = lower
+	b.weightUpper = upper
+	return b
+}
+
+// Scenarios sets a user-supplied scenario matrix of simulated returns (S
+// scenarios x N assets, in TickerSymbols order) used by
+// Portfolio.RobustMaxSharpePortfolio and Portfolio.CVaRMinPortfolio in place
+// of the symbols' historical returns. Leave unset to have both methods fall
+// back to the symbols' own historical returns as the scenario set.
+//
+// Parameters:
+//   - returns: The scenario matrix, one row per scenario and one column per
+//     symbol in TickerSymbols order.
+//
+// Returns:
+//   - *PortfolioBuilder: The builder instance for method chaining.
+//
+// Example:
+//
+//	builder := finalytics.NewPortfolioBuilder().
+//		TickerSymbols([]string{"AAPL", "MSFT"}).
+//		Scenarios([][]float64{{0.01, -0.02}, {-0.015, 0.03}, {0.02, 0.01}})
+func (b *PortfolioBuilder) Scenarios(returns [][]float64) *PortfolioBuilder {
+	b.scenarios = returns
+	return b
+}
+
+// UncertaintySet configures the uncertainty set Portfolio.RobustMaxSharpePortfolio
+// uses around the scenario mean estimate.
+//
+// Parameters:
+//   - kind: The uncertainty set's shape, "box" or "ellipsoidal".
+//   - params: Set parameters; "ellipsoidal" and "box" both read a "kappa"
+//     radius (0 if absent, which disables robustness and reduces to the
+//     plain scenario mean).
+//
+// Returns:
+//   - *PortfolioBuilder: The builder instance for method chaining.
+//
+// Example:
+//
+//	builder := finalytics.NewPortfolioBuilder().
+//		TickerSymbols([]string{"AAPL", "MSFT"}).
+//		UncertaintySet("ellipsoidal", map[string]float64{"kappa": 0.1})
+func (b *PortfolioBuilder) UncertaintySet(kind string, params map[string]float64) *PortfolioBuilder {
+	b.uncertaintyKind = kind
+	b.uncertaintyParams = params
+	return b
+}
+
 // Build constructs the Portfolio instance with the configured parameters.
 // The tickerSymbols parameter is required; other parameters are optional and use defaults if not set.
 //
@@ -379,7 +542,7 @@ func (b *PortfolioBuilder) Build() (*Portfolio, error) {
 	defer C.free(unsafe.Pointer(cEndDate))
 	cInterval := C.CString(b.interval)
 	defer C.free(unsafe.Pointer(cInterval))
-	cObjectiveFunction := C.CString(b.objectiveFunction)
+	cObjectiveFunction := C.CString(string(b.objectiveFunction))
 	defer C.free(unsafe.Pointer(cObjectiveFunction))
 	cAssetConstraints := C.CString(b.assetConstraints)
 	defer C.free(unsafe.Pointer(cAssetConstraints))
@@ -433,7 +596,25 @@ func (b *PortfolioBuilder) Build() (*Portfolio, error) {
 	if handle == nil {
 		return nil, errors.New("failed to create Portfolio")
 	}
-	return &Portfolio{handle: handle}, nil
+	return &Portfolio{
+		handle:            handle,
+		objective:         b.objectiveFunction,
+		interval:          b.interval,
+		confidenceLevel:   b.confidenceLevel,
+		startDate:         b.startDate,
+		endDate:           b.endDate,
+		symbols:           b.tickerSymbols,
+		equalityA:         b.equalityA,
+		equalityB:         b.equalityB,
+		inequalityC:       b.inequalityC,
+		inequalityLower:   b.inequalityLower,
+		inequalityUpper:   b.inequalityUpper,
+		weightLower:       b.weightLower,
+		weightUpper:       b.weightUpper,
+		scenarios:         b.scenarios,
+		uncertaintyKind:   b.uncertaintyKind,
+		uncertaintyParams: b.uncertaintyParams,
+	}, nil
 }
 
 // Free releases the resources associated with the Portfolio.
@@ -514,6 +695,95 @@ func (p *Portfolio) OptimizationResults() (map[string]any, error) {
 	return parseJSONResult(cOutput)
 }
 
+// ObjectiveValue returns the value achieved for the Objective the Portfolio
+// was optimized for (e.g. the Sortino ratio when built with
+// ObjectiveMaxSortino), computed from the portfolio's daily returns series.
+//
+// Returns:
+//   - float64: The achieved objective value.
+//   - error: An error if the returns retrieval fails, or if achieved-value
+//     computation is not supported for the Portfolio's objective.
+//
+// Example:
+//
+//	value, err := portfolio.ObjectiveValue()
+//	if err != nil {
+//		fmt.Printf("Failed to get objective value: %v\n", err)
+//		return
+//	}
+//	fmt.Printf("Objective Value: %v\n", value)
+func (p *Portfolio) ObjectiveValue() (float64, error) {
+	returns, err := p.Returns()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get returns: %v", err)
+	}
+	values := returns.Col("portfolio_returns").Float()
+
+	switch p.objective {
+	case ObjectiveMaxSortino:
+		return sortinoRatio(values, 0), nil
+	case ObjectiveMaxCalmar:
+		equity := equityFromReturns(values)
+		periodsPerYear := annualPeriods(p.interval)
+		return calmarRatio(annualizedReturn(values, periodsPerYear), maxDrawdown(equity)), nil
+	case ObjectiveMaxOmega:
+		return omegaRatio(values, 0), nil
+	case ObjectiveMaxProfitFactor:
+		return computeTradeStats(values).ProfitFactor, nil
+	case ObjectiveMinCVaR:
+		alpha := p.confidenceLevel
+		if alpha <= 0 || alpha >= 1 {
+			alpha = 0.95
+		}
+		return cvar(values, alpha), nil
+	case ObjectiveMinDrawdown:
+		return maxDrawdown(equityFromReturns(values)), nil
+	default:
+		return 0, fmt.Errorf("objective value computation is not supported for objective %q", p.objective)
+	}
+}
+
+// Returns retrieves the portfolio's daily returns series.
+//
+// Returns:
+//   - dataframe.DataFrame: A DataFrame containing the portfolio's returns data.
+//   - error: An error if the returns retrieval fails.
+//
+// Example:
+//
+//	package main
+//
+//	import (
+//		"fmt"
+//		"github.com/Nnamdi-sys/finalytics/go/finalytics"
+//	)
+//
+//	func main() {
+//		portfolio, err := finalytics.NewPortfolioBuilder().
+//			TickerSymbols([]string{"AAPL", "MSFT", "NVDA", "BTC-USD"}).
+//			Build()
+//		if err != nil {
+//			fmt.Printf("Failed to create Portfolio: %v\n", err)
+//			return
+//		}
+//		defer portfolio.Free()
+//
+//		returns, err := portfolio.Returns()
+//		if err != nil {
+//			fmt.Printf("Failed to get returns: %v\n", err)
+//			return
+//		}
+//		fmt.Printf("Returns:\n%v\n", returns)
+//	}
+func (p *Portfolio) Returns() (dataframe.DataFrame, error) {
+	var cOutput *C.char
+	result := C.finalytics_portfolio_returns(p.handle, &cOutput)
+	if result != 0 {
+		return dataframe.DataFrame{}, fmt.Errorf("failed to get returns: error code %d", result)
+	}
+	return parseJSONToDataFrame(cOutput)
+}
+
 // OptimizationChart retrieves the portfolio optimization chart as an HTML object.
 //
 // Parameters:
@@ -611,7 +881,7 @@ func (p *Portfolio) OptimizationChart(height, width uint) (HTML, error) {
 //		}
 //		perfChart.Show()
 //	}
-func (p *Portfolio) PerformanceChart(height, width uint) (HTML, error) {
+func (p *Portfolio) PerformanceChart(height, width uint, opts ...ChartOptions) (HTML, error) {
 	var cOutput *C.char
 	result := C.finalytics_portfolio_performance_chart(p.handle, C.uint(height), C.uint(width), &cOutput)
 	if result != 0 {
@@ -619,7 +889,7 @@ func (p *Portfolio) PerformanceChart(height, width uint) (HTML, error) {
 	}
 	defer C.finalytics_free_string(cOutput)
 	htmlStr := C.GoString(cOutput)
-	return HTML{Content: htmlStr}, nil
+	return HTML{Content: applyChartOptions(htmlStr, opts...)}, nil
 }
 
 // AssetReturnsChart retrieves the asset returns chart for the portfolio as an HTML object.
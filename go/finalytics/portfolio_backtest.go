@@ -0,0 +1,485 @@
+package finalytics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// RebalanceSchedule names how often a PortfolioBacktester refits the
+// optimizer and rebalances its holdings.
+type RebalanceSchedule string
+
+const (
+	// RebalanceDaily refits and rebalances on every bar.
+	RebalanceDaily RebalanceSchedule = "daily"
+	// RebalanceWeekly refits and rebalances roughly every 7 calendar days.
+	RebalanceWeekly RebalanceSchedule = "weekly"
+	// RebalanceMonthly refits and rebalances roughly every calendar month.
+	RebalanceMonthly RebalanceSchedule = "monthly"
+	// RebalanceQuarterly refits and rebalances roughly every calendar quarter.
+	RebalanceQuarterly RebalanceSchedule = "quarterly"
+)
+
+// TransactionCosts models the cost of turning over a PortfolioBacktester's
+// holdings at a rebalance date.
+type TransactionCosts struct {
+	BPS       float64 // cost per unit of turnover, in basis points of traded notional
+	FixedCost float64 // flat cost charged on any rebalance date that trades
+}
+
+// PortfolioTrade records the rebalance-date change in one symbol's target
+// weight, along with the share of that rebalance's transaction cost it incurred.
+type PortfolioTrade struct {
+	Date        time.Time
+	Symbol      string
+	WeightDelta float64
+	Cost        float64
+}
+
+// PortfolioPeriodWeights holds the weights a PortfolioBacktester held from
+// Date until the next rebalance (or the end of the backtest).
+type PortfolioPeriodWeights struct {
+	Date    time.Time
+	Weights map[string]float64
+}
+
+// PortfolioBacktestResult holds a PortfolioBacktester.Run's output.
+type PortfolioBacktestResult struct {
+	Dates       []time.Time
+	EquityCurve []float64 // portfolio value at the close of each date; the first point is the initial capital less the first rebalance's transaction cost
+	TotalReturn float64   // (final equity / initial capital) - 1
+	MaxDrawdown float64   // largest peak-to-trough decline in EquityCurve, as a positive fraction
+	Trades      []PortfolioTrade
+	Weights     []PortfolioPeriodWeights
+}
+
+// PortfolioBacktester walk-forwards a *PortfolioBuilder's optimizer over its
+// configured date range: at every rebalance date it refits the optimizer over
+// the trailing lookback window, applies turnover and transaction costs, then
+// holds the resulting weights until the next rebalance date. This evaluates a
+// strategy under periodic, realistic execution rather than the single-shot
+// optimization over one fixed window that Portfolio.OptimizationResults performs.
+type PortfolioBacktester struct {
+	builder        *PortfolioBuilder
+	schedule       RebalanceSchedule
+	lookback       time.Duration
+	costs          TransactionCosts
+	initialCapital float64
+}
+
+// NewPortfolioBacktester creates a PortfolioBacktester that walk-forwards
+// builder's optimizer (symbols, objective, constraints, etc.) across its
+// configured StartDate/EndDate range.
+//
+// Parameters:
+//   - builder: A configured *PortfolioBuilder; its StartDate/EndDate bound
+//     the backtest, and every other setting (objective, constraints, ...) is
+//     reused unchanged at every rebalance.
+//   - schedule: How often to refit and rebalance (RebalanceDaily,
+//     RebalanceWeekly, RebalanceMonthly or RebalanceQuarterly).
+//   - lookback: The length of the trailing window refit at each rebalance date.
+//   - costs: The transaction cost model applied to each rebalance's turnover.
+//   - initialCapital: The starting portfolio value.
+//
+// Returns:
+//   - *PortfolioBacktester: The configured backtester.
+//
+// Example:
+//
+//	builder := finalytics.NewPortfolioBuilder().
+//		TickerSymbols([]string{"AAPL", "MSFT", "NVDA"}).
+//		StartDate("2020-01-01").
+//		EndDate("2023-12-31").
+//		ObjectiveFunction(finalytics.ObjectiveMaxSharpe)
+//	backtester := finalytics.NewPortfolioBacktester(builder, finalytics.RebalanceMonthly,
+//		180*24*time.Hour, finalytics.TransactionCosts{BPS: 10}, 100000)
+//	result, err := backtester.Run()
+func NewPortfolioBacktester(builder *PortfolioBuilder, schedule RebalanceSchedule, lookback time.Duration, costs TransactionCosts, initialCapital float64) *PortfolioBacktester {
+	return &PortfolioBacktester{
+		builder:        builder,
+		schedule:       schedule,
+		lookback:       lookback,
+		costs:          costs,
+		initialCapital: initialCapital,
+	}
+}
+
+// nextRebalanceDate returns the earliest calendar date on or after which the
+// next rebalance is due, per bt.schedule.
+func (bt *PortfolioBacktester) nextRebalanceDate(from time.Time) (time.Time, error) {
+	switch bt.schedule {
+	case RebalanceDaily:
+		return from.AddDate(0, 0, 1), nil
+	case RebalanceWeekly:
+		return from.AddDate(0, 0, 7), nil
+	case RebalanceMonthly:
+		return from.AddDate(0, 1, 0), nil
+	case RebalanceQuarterly:
+		return from.AddDate(0, 3, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported rebalance schedule %q; supported schedules are %q, %q, %q and %q",
+			bt.schedule, RebalanceDaily, RebalanceWeekly, RebalanceMonthly, RebalanceQuarterly)
+	}
+}
+
+// cloneBuilderForWindow returns a shallow copy of bt.builder with its date
+// range restricted to [start, end], used to refit the optimizer over a single
+// trailing lookback window without disturbing the original builder.
+func (bt *PortfolioBacktester) cloneBuilderForWindow(start, end time.Time) *PortfolioBuilder {
+	windowed := *bt.builder
+	windowed.startDate = start.Format("2006-01-02")
+	windowed.endDate = end.Format("2006-01-02")
+	return &windowed
+}
+
+// refit builds and optimizes a Portfolio over the lookback window ending on
+// rebalanceDate, and returns its optimized weights.
+func (bt *PortfolioBacktester) refit(rebalanceDate time.Time) (map[string]float64, error) {
+	windowBuilder := bt.cloneBuilderForWindow(rebalanceDate.Add(-bt.lookback), rebalanceDate)
+	portfolio, err := windowBuilder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to refit portfolio for window ending %s: %v", rebalanceDate.Format("2006-01-02"), err)
+	}
+	defer portfolio.Free()
+
+	results, err := portfolio.OptimizationResults()
+	if err != nil {
+		return nil, fmt.Errorf("failed to optimize window ending %s: %v", rebalanceDate.Format("2006-01-02"), err)
+	}
+	return extractOptimizedWeights(results, bt.builder.tickerSymbols)
+}
+
+// Run walk-forwards the backtester across its builder's date range, refitting
+// on bt.schedule and applying bt.costs to every rebalance's turnover.
+//
+// Returns:
+//   - *PortfolioBacktestResult: The full equity curve, trade log and per-period weights.
+//   - error: An error if price history can't be fetched, there isn't enough
+//     history for a single lookback window, or a rebalance date's refit fails.
+//
+// Example:
+//
+//	result, err := backtester.Run()
+//	if err != nil {
+//		fmt.Printf("Failed to run backtest: %v\n", err)
+//		return
+//	}
+//	fmt.Printf("Total return: %.2f%%\n", result.TotalReturn*100)
+func (bt *PortfolioBacktester) Run() (*PortfolioBacktestResult, error) {
+	dates, closes, err := alignedCloses(bt.builder.tickerSymbols, bt.builder.startDate, bt.builder.endDate, bt.builder.interval)
+	if err != nil {
+		return nil, err
+	}
+	if len(dates) < 2 {
+		return nil, fmt.Errorf("fewer than 2 aligned dates available across symbols %v", bt.builder.tickerSymbols)
+	}
+
+	returns := make(map[string][]float64, len(closes))
+	for symbol, closeSeries := range closes {
+		r := make([]float64, len(closeSeries))
+		for i := 1; i < len(closeSeries); i++ {
+			if closeSeries[i-1] != 0 {
+				r[i] = closeSeries[i]/closeSeries[i-1] - 1
+			}
+		}
+		returns[symbol] = r
+	}
+
+	firstRebalance := dates[0].Add(bt.lookback)
+	startIdx := sort.Search(len(dates), func(i int) bool { return !dates[i].Before(firstRebalance) })
+	if startIdx >= len(dates) {
+		return nil, fmt.Errorf("not enough history for a %s lookback window before %s", bt.lookback, bt.builder.endDate)
+	}
+
+	resultDates := dates[startIdx:]
+	equity := make([]float64, len(resultDates))
+	currentWeights := map[string]float64{}
+	var trades []PortfolioTrade
+	var weightsLog []PortfolioPeriodWeights
+
+	nextRebalance := resultDates[0]
+	for i, d := range resultDates {
+		if i == 0 {
+			equity[i] = bt.initialCapital
+		} else {
+			idx := startIdx + i
+			periodReturn := 0.0
+			for symbol, w := range currentWeights {
+				periodReturn += w * returns[symbol][idx]
+			}
+			equity[i] = equity[i-1] * (1 + periodReturn)
+		}
+
+		if d.Before(nextRebalance) {
+			continue
+		}
+
+		newWeights, err := bt.refit(d)
+		if err != nil {
+			return nil, err
+		}
+
+		turnover := 0.0
+		for _, symbol := range bt.builder.tickerSymbols {
+			delta := newWeights[symbol] - currentWeights[symbol]
+			turnover += math.Abs(delta)
+		}
+		cost := turnover * equity[i] * bt.costs.BPS / 10000
+		if turnover > 0 {
+			cost += bt.costs.FixedCost
+		}
+		equity[i] -= cost
+
+		for _, symbol := range bt.builder.tickerSymbols {
+			delta := newWeights[symbol] - currentWeights[symbol]
+			if delta == 0 {
+				continue
+			}
+			share := 0.0
+			if turnover > 0 {
+				share = cost * (math.Abs(delta) / turnover)
+			}
+			trades = append(trades, PortfolioTrade{Date: d, Symbol: symbol, WeightDelta: delta, Cost: share})
+		}
+
+		currentWeights = newWeights
+		weightsLog = append(weightsLog, PortfolioPeriodWeights{Date: d, Weights: newWeights})
+
+		next, err := bt.nextRebalanceDate(d)
+		if err != nil {
+			return nil, err
+		}
+		nextRebalance = next
+	}
+
+	return &PortfolioBacktestResult{
+		Dates:       resultDates,
+		EquityCurve: equity,
+		TotalReturn: (equity[len(equity)-1] / bt.initialCapital) - 1,
+		MaxDrawdown: maxDrawdown(equity),
+		Trades:      trades,
+		Weights:     weightsLog,
+	}, nil
+}
+
+// alignedCloses fetches each symbol's close price history over
+// [startDate, endDate] and returns the sorted, common set of dates across all
+// symbols along with each symbol's close aligned to those dates, mirroring
+// Tickers.alignedReturns' approach of fetching per-symbol and intersecting on
+// shared timestamps.
+func alignedCloses(symbols []string, startDate, endDate, interval string) ([]time.Time, map[string][]float64, error) {
+	closesByDate := make(map[time.Time]map[string]float64)
+
+	for _, symbol := range symbols {
+		ticker, err := NewTickerBuilder().
+			Symbol(symbol).
+			StartDate(startDate).
+			EndDate(endDate).
+			Interval(interval).
+			Build()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to build ticker for %q: %v", symbol, err)
+		}
+		history, err := ticker.GetPriceHistory()
+		ticker.Free()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get price history for %q: %v", symbol, err)
+		}
+
+		timestamps := history.Col("timestamp").Records()
+		closesCol := history.Col("close").Float()
+		for i, raw := range timestamps {
+			ts, err := parseCandleTimestamp(raw)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse timestamp %q: %v", raw, err)
+			}
+			if closesByDate[ts] == nil {
+				closesByDate[ts] = make(map[string]float64, len(symbols))
+			}
+			closesByDate[ts][symbol] = closesCol[i]
+		}
+	}
+
+	var dates []time.Time
+	for ts, bySymbol := range closesByDate {
+		if len(bySymbol) == len(symbols) {
+			dates = append(dates, ts)
+		}
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	if len(dates) == 0 {
+		return nil, nil, fmt.Errorf("no common dates across symbols %v", symbols)
+	}
+
+	closes := make(map[string][]float64, len(symbols))
+	for _, symbol := range symbols {
+		closeSeries := make([]float64, len(dates))
+		for i, ts := range dates {
+			closeSeries[i] = closesByDate[ts][symbol]
+		}
+		closes[symbol] = closeSeries
+	}
+	return dates, closes, nil
+}
+
+// EquityCurveChart renders the backtest's equity curve as an HTML line chart.
+//
+// Parameters:
+//   - height: The height of the chart (0 for default).
+//   - width: The width of the chart (0 for default).
+//
+// Returns:
+//   - HTML: An HTML object containing the equity curve chart.
+//   - error: An error if the result has no equity curve to chart.
+//
+// Example:
+//
+//	chart, err := result.EquityCurveChart(0, 0)
+//	if err != nil {
+//		fmt.Printf("Failed to get equity curve chart: %v\n", err)
+//		return
+//	}
+//	chart.Show()
+func (r *PortfolioBacktestResult) EquityCurveChart(height, width uint) (HTML, error) {
+	if len(r.EquityCurve) == 0 {
+		return HTML{}, fmt.Errorf("backtest result has no equity curve to chart")
+	}
+	return HTML{Content: renderBacktestLineChart("Equity Curve", formatDates(r.Dates), r.EquityCurve, height, width)}, nil
+}
+
+// DrawdownChart renders the backtest's peak-to-date drawdown series as an
+// HTML line chart.
+//
+// Parameters:
+//   - height: The height of the chart (0 for default).
+//   - width: The width of the chart (0 for default).
+//
+// Returns:
+//   - HTML: An HTML object containing the drawdown chart.
+//   - error: An error if the result has no equity curve to chart.
+//
+// Example:
+//
+//	chart, err := result.DrawdownChart(0, 0)
+//	if err != nil {
+//		fmt.Printf("Failed to get drawdown chart: %v\n", err)
+//		return
+//	}
+//	chart.Show()
+func (r *PortfolioBacktestResult) DrawdownChart(height, width uint) (HTML, error) {
+	if len(r.EquityCurve) == 0 {
+		return HTML{}, fmt.Errorf("backtest result has no equity curve to chart")
+	}
+	return HTML{Content: renderBacktestLineChart("Drawdown", formatDates(r.Dates), drawdownSeries(r.EquityCurve), height, width)}, nil
+}
+
+// TradeLogDataFrame builds a per-trade DataFrame (date, symbol, weight_delta,
+// cost) from r.Trades, suitable for exporting or rendering.
+//
+// Returns:
+//   - dataframe.DataFrame: One row per trade, in chronological order.
+//
+// Example:
+//
+//	df := result.TradeLogDataFrame()
+//	fmt.Println(df)
+func (r *PortfolioBacktestResult) TradeLogDataFrame() dataframe.DataFrame {
+	n := len(r.Trades)
+	dates := make([]string, n)
+	symbols := make([]string, n)
+	weightDeltas := make([]float64, n)
+	costs := make([]float64, n)
+	for i, t := range r.Trades {
+		dates[i] = t.Date.Format("2006-01-02")
+		symbols[i] = t.Symbol
+		weightDeltas[i] = t.WeightDelta
+		costs[i] = t.Cost
+	}
+	return dataframe.New(
+		series.New(dates, series.String, "date"),
+		series.New(symbols, series.String, "symbol"),
+		series.New(weightDeltas, series.Float, "weight_delta"),
+		series.New(costs, series.Float, "cost"),
+	)
+}
+
+// formatDates formats each time.Time in dates as "2006-01-02".
+func formatDates(dates []time.Time) []string {
+	labels := make([]string, len(dates))
+	for i, d := range dates {
+		labels[i] = d.Format("2006-01-02")
+	}
+	return labels
+}
+
+// renderBacktestLineChart builds a self-contained single-series SVG line
+// chart document, in the same hand-rolled HTML style as RollingReturnsChart's
+// heatmap-over-time (no Plotly/JS chart library dependency).
+func renderBacktestLineChart(title string, labels []string, values []float64, height, width uint) string {
+	if len(values) == 0 {
+		return fmt.Sprintf(`<!DOCTYPE html><html><head><meta charset="utf-8"><title>%s</title></head><body><p>no data</p></body></html>`, title)
+	}
+	return fmt.Sprintf(`<!DOCTYPE html><html><head><meta charset="utf-8"><title>%s</title></head>
+<body>
+<h3>%s</h3>
+%s
+</body></html>`, title, title, svgLineChart(labels, values, height, width))
+}
+
+// svgLineChart renders labels/values as a single-series SVG polyline plus a
+// first/last label caption, sized height x width (defaulting to 400x900).
+// It returns a fragment, not a full document, so callers can embed it
+// alongside other content (e.g. TradeStatisticsChart's summary table).
+func svgLineChart(labels []string, values []float64, height, width uint) string {
+	if height == 0 {
+		height = 400
+	}
+	if width == 0 {
+		width = 900
+	}
+	if len(values) == 0 {
+		return "<p>no data</p>"
+	}
+
+	minV, maxV := values[0], values[0]
+	for _, v := range values {
+		if v < minV {
+			minV = v
+		}
+		if v > maxV {
+			maxV = v
+		}
+	}
+	if maxV == minV {
+		maxV++
+		minV--
+	}
+
+	const padding = 30.0
+	plotW := float64(width) - 2*padding
+	plotH := float64(height) - 2*padding
+
+	var points strings.Builder
+	for i, v := range values {
+		x := padding
+		if len(values) > 1 {
+			x += plotW * float64(i) / float64(len(values)-1)
+		}
+		y := padding + plotH*(1-(v-minV)/(maxV-minV))
+		if i > 0 {
+			points.WriteString(" ")
+		}
+		fmt.Fprintf(&points, "%.2f,%.2f", x, y)
+	}
+
+	return fmt.Sprintf(`<svg width="%d" height="%d" viewBox="0 0 %d %d" xmlns="http://www.w3.org/2000/svg">
+<polyline fill="none" stroke="#1f77b4" stroke-width="2" points="%s" />
+</svg>
+<div>%s &mdash; %s</div>`, width, height, width, height, points.String(), labels[0], labels[len(labels)-1])
+}
@@ -0,0 +1,24 @@
+package finalytics
+
+/*
+#include <finalytics.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"context"
+
+	"github.com/go-gota/gota/dataframe"
+)
+
+// ReturnsContext is Returns, but the underlying FFI call runs on a goroutine
+// and is abandoned early if ctx is done before it returns. On failure it
+// returns one of ErrRateLimited, ErrSymbolNotFound or ErrNetwork instead of
+// an opaque error code where the underlying cause is known.
+func (p *Portfolio) ReturnsContext(ctx context.Context) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get returns", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_portfolio_returns(p.handle, &cOutput)
+		return cOutput, code
+	})
+}
@@ -0,0 +1,267 @@
+package finalytics
+
+import (
+	"fmt"
+
+	"github.com/go-gota/gota/dataframe"
+)
+
+// RebalanceTrade is one symbol's suggested trade in a Portfolio.Rebalance
+// instruction: how far its weight needs to move to reach the target.
+type RebalanceTrade struct {
+	Symbol        string
+	CurrentWeight float64
+	TargetWeight  float64
+	WeightDelta   float64 // TargetWeight - CurrentWeight; positive = buy, negative = sell
+}
+
+// RebalanceInstruction is Portfolio.Rebalance's output: the Portfolio's
+// current target weights alongside the per-symbol trades needed to move a
+// supplied current-holdings vector onto them.
+type RebalanceInstruction struct {
+	TargetWeights map[string]float64
+	Trades        []RebalanceTrade
+}
+
+// ensureLiveState seeds p's cached return series and per-symbol last close
+// from historical price history on first use, so Update/UpdateBatch have a
+// base to extend from. Safe to call repeatedly; later calls are no-ops once seeded.
+func (p *Portfolio) ensureLiveState() error {
+	p.liveMu.RLock()
+	seeded := p.returnSeries != nil
+	p.liveMu.RUnlock()
+	if seeded {
+		return nil
+	}
+
+	returnSeries, err := p.historicalReturnSeries()
+	if err != nil {
+		return err
+	}
+	lastCloses := make([]float64, len(p.symbols))
+	for i, symbol := range p.symbols {
+		ticker, err := NewTickerBuilder().
+			Symbol(symbol).
+			StartDate(p.startDate).
+			EndDate(p.endDate).
+			Interval(p.interval).
+			Build()
+		if err != nil {
+			return fmt.Errorf("failed to build ticker for %q: %v", symbol, err)
+		}
+		candles, err := ticker.allCandles()
+		ticker.Free()
+		if err != nil {
+			return fmt.Errorf("failed to get candles for %q: %v", symbol, err)
+		}
+		closes := closesOf(candles)
+		if len(closes) == 0 {
+			return fmt.Errorf("no price history for %q", symbol)
+		}
+		lastCloses[i] = closes[len(closes)-1]
+	}
+
+	p.liveMu.Lock()
+	defer p.liveMu.Unlock()
+	if p.returnSeries == nil {
+		p.returnSeries = returnSeries
+		p.lastCloses = lastCloses
+	}
+	return nil
+}
+
+// Update appends a single fresh price bar to the Portfolio's cached return
+// series without rebuilding the underlying handle: newBar maps each of the
+// Portfolio's symbols to its latest close, which is turned into one more
+// period-over-period simple return against the previously recorded close.
+// The first call (on any Portfolio) seeds the cache from the symbols' full
+// StartDate/EndDate price history, so later calls to CurrentWeights,
+// Rebalance, MinVariancePortfolio, EfficientFrontier, TangencyPortfolio,
+// RobustMaxSharpePortfolio and CVaRMinPortfolio all refit over the extended
+// series without a further network fetch for the bars already appended.
+//
+// Parameters:
+//   - newBar: The latest close for each of the Portfolio's symbols, keyed by symbol.
+//
+// Returns:
+//   - error: An error if newBar is missing a symbol, a close isn't positive,
+//     or the initial price history can't be fetched.
+//
+// Example:
+//
+//	err := portfolio.Update(map[string]float64{"AAPL": 231.50, "MSFT": 415.20})
+//	if err != nil {
+//		fmt.Printf("Failed to update portfolio: %v\n", err)
+//		return
+//	}
+func (p *Portfolio) Update(newBar map[string]float64) error {
+	if err := p.ensureLiveState(); err != nil {
+		return err
+	}
+
+	p.liveMu.Lock()
+	defer p.liveMu.Unlock()
+
+	// Validate every symbol before mutating anything: a partial update would
+	// leave p.returnSeries's per-symbol slices at different lengths, which
+	// panics the next covarianceAndMeans/sampleCovariance call.
+	for i, symbol := range p.symbols {
+		price, ok := newBar[symbol]
+		if !ok {
+			return fmt.Errorf("newBar is missing symbol %q", symbol)
+		}
+		if price <= 0 || p.lastCloses[i] <= 0 {
+			return fmt.Errorf("invalid close for symbol %q", symbol)
+		}
+	}
+	for i, symbol := range p.symbols {
+		price := newBar[symbol]
+		p.returnSeries[i] = append(p.returnSeries[i], price/p.lastCloses[i]-1)
+		p.lastCloses[i] = price
+	}
+	return nil
+}
+
+// UpdateBatch is Update's counterpart for backfilling more than one bar at a
+// time: newBars holds one dataframe.DataFrame per symbol, in TickerSymbols
+// order, each with a "close" column of one or more new bars in chronological
+// order. Every DataFrame's rows are appended in lockstep, oldest first.
+//
+// Parameters:
+//   - newBars: One DataFrame per symbol (TickerSymbols order), each with a "close" column.
+//
+// Returns:
+//   - error: An error if newBars doesn't have one DataFrame per symbol, the
+//     DataFrames don't all have the same row count, a close isn't positive,
+//     or the initial price history can't be fetched.
+//
+// Example:
+//
+//	err := portfolio.UpdateBatch([]dataframe.DataFrame{aaplBars, msftBars})
+//	if err != nil {
+//		fmt.Printf("Failed to update portfolio: %v\n", err)
+//		return
+//	}
+func (p *Portfolio) UpdateBatch(newBars []dataframe.DataFrame) error {
+	if len(newBars) != len(p.symbols) {
+		return fmt.Errorf("newBars must have one DataFrame per symbol, got %d for %d symbols", len(newBars), len(p.symbols))
+	}
+	if err := p.ensureLiveState(); err != nil {
+		return err
+	}
+
+	closesPerSymbol := make([][]float64, len(p.symbols))
+	for i, df := range newBars {
+		closesPerSymbol[i] = df.Col("close").Float()
+	}
+	rows := len(closesPerSymbol[0])
+	for i, closes := range closesPerSymbol {
+		if len(closes) != rows {
+			return fmt.Errorf("newBars[%d] (%q) has %d rows, expected %d", i, p.symbols[i], len(closes), rows)
+		}
+	}
+
+	p.liveMu.Lock()
+	defer p.liveMu.Unlock()
+
+	// Validate every row before mutating anything: a partial update would
+	// leave p.returnSeries's per-symbol slices at different lengths, which
+	// panics the next covarianceAndMeans/sampleCovariance call.
+	lastCloses := append([]float64(nil), p.lastCloses...)
+	for row := 0; row < rows; row++ {
+		for i := range p.symbols {
+			price := closesPerSymbol[i][row]
+			if price <= 0 || lastCloses[i] <= 0 {
+				return fmt.Errorf("invalid close for symbol %q at row %d", p.symbols[i], row)
+			}
+			lastCloses[i] = price
+		}
+	}
+	for row := 0; row < rows; row++ {
+		for i := range p.symbols {
+			price := closesPerSymbol[i][row]
+			p.returnSeries[i] = append(p.returnSeries[i], price/p.lastCloses[i]-1)
+			p.lastCloses[i] = price
+		}
+	}
+	return nil
+}
+
+// CurrentWeights refits the Portfolio's Objective over its (possibly
+// Update/UpdateBatch-extended) return series and returns the resulting
+// target weights, without rebuilding the underlying handle. It dispatches
+// to the client-side solver matching the Portfolio's objective:
+// MinVariancePortfolio for ObjectiveMinVol, RobustMaxSharpePortfolio for
+// ObjectiveRobustMaxSharpe, CVaRMinPortfolio for ObjectiveCVaRMin, and
+// TangencyPortfolio(0) for ObjectiveMaxSharpe (or an unset objective).
+//
+// Returns:
+//   - map[string]float64: The optimal weight for each symbol.
+//   - error: An error if the objective has no client-side equivalent, or the
+//     underlying solve fails.
+//
+// Example:
+//
+//	weights, err := portfolio.CurrentWeights()
+//	if err != nil {
+//		fmt.Printf("Failed to refit current weights: %v\n", err)
+//		return
+//	}
+//	fmt.Printf("Current target weights: %v\n", weights)
+func (p *Portfolio) CurrentWeights() (map[string]float64, error) {
+	switch p.objective {
+	case ObjectiveMinVol:
+		return p.MinVariancePortfolio()
+	case ObjectiveRobustMaxSharpe:
+		return p.RobustMaxSharpePortfolio()
+	case ObjectiveCVaRMin:
+		return p.CVaRMinPortfolio()
+	case ObjectiveMaxSharpe, "":
+		return p.TangencyPortfolio(0)
+	default:
+		return nil, fmt.Errorf("CurrentWeights has no client-side solver for objective %q; call MinVariancePortfolio, TangencyPortfolio, EfficientFrontier, RobustMaxSharpePortfolio or CVaRMinPortfolio directly", p.objective)
+	}
+}
+
+// Rebalance compares currentWeights (a caller-supplied snapshot of current
+// holdings, keyed by symbol and expressed as a fraction of portfolio value)
+// against CurrentWeights' freshly refit target weights, and returns the
+// per-symbol trade needed to close the gap.
+//
+// Parameters:
+//   - currentWeights: The current weight of each symbol in the live
+//     portfolio, keyed by symbol; a missing symbol is treated as a 0 holding.
+//
+// Returns:
+//   - RebalanceInstruction: The target weights and the trades needed to reach them.
+//   - error: An error if CurrentWeights fails.
+//
+// Example:
+//
+//	instruction, err := portfolio.Rebalance(map[string]float64{"AAPL": 0.6, "MSFT": 0.4})
+//	if err != nil {
+//		fmt.Printf("Failed to compute rebalance instruction: %v\n", err)
+//		return
+//	}
+//	for _, trade := range instruction.Trades {
+//		fmt.Printf("%s: %+.4f\n", trade.Symbol, trade.WeightDelta)
+//	}
+func (p *Portfolio) Rebalance(currentWeights map[string]float64) (RebalanceInstruction, error) {
+	target, err := p.CurrentWeights()
+	if err != nil {
+		return RebalanceInstruction{}, err
+	}
+
+	trades := make([]RebalanceTrade, 0, len(p.symbols))
+	for _, symbol := range p.symbols {
+		current := currentWeights[symbol]
+		targetWeight := target[symbol]
+		trades = append(trades, RebalanceTrade{
+			Symbol:        symbol,
+			CurrentWeight: current,
+			TargetWeight:  targetWeight,
+			WeightDelta:   targetWeight - current,
+		})
+	}
+	return RebalanceInstruction{TargetWeights: target, Trades: trades}, nil
+}
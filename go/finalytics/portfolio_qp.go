@@ -0,0 +1,478 @@
+package finalytics
+
+import (
+	"fmt"
+	"math"
+)
+
+// FrontierPoint is one solved portfolio on a Portfolio's efficient frontier,
+// as returned by Portfolio.EfficientFrontier.
+type FrontierPoint struct {
+	Weights        map[string]float64
+	ExpectedReturn float64
+	Volatility     float64
+}
+
+// quadraticProgram is the standard form solved by solveQP:
+//
+//	min  c^T x + (1/2) x^T Q x
+//	s.t. Ax = b, IneqLower <= IneqC*x <= IneqUpper, Lower <= x <= Upper
+type quadraticProgram struct {
+	Q                    [][]float64
+	C                    []float64
+	A                    [][]float64
+	B                    []float64
+	IneqC                [][]float64
+	IneqLower, IneqUpper []float64
+	Lower, Upper         []float64
+}
+
+// solveQP solves qp by a quadratic-penalty projected-gradient method: the
+// equality and inequality constraints are folded into the objective as
+// squared-violation penalty terms whose weight grows geometrically across
+// outer iterations, while each inner iteration takes a gradient step and
+// then projects x back onto [Lower, Upper]. Box bounds are therefore always
+// satisfied exactly; Ax=b and the general inequalities are satisfied only
+// in the limit as the penalty weight grows, which is the same tradeoff
+// Ticker.ImpliedVolatility's Newton/bisection solver makes between exactness
+// and a bounded iteration count.
+func solveQP(qp quadraticProgram) ([]float64, error) {
+	n := len(qp.Q)
+	if n == 0 {
+		return nil, fmt.Errorf("quadratic program has no variables")
+	}
+
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+	x = projectBounds(x, qp.Lower, qp.Upper)
+
+	const (
+		outerIterations = 25
+		innerIterations = 300
+		initialPenalty  = 50.0
+		penaltyGrowth   = 2.0
+		baseStep        = 0.05
+	)
+
+	penalty := initialPenalty
+	for outer := 0; outer < outerIterations; outer++ {
+		step := baseStep / penalty
+		for inner := 0; inner < innerIterations; inner++ {
+			grad := qpGradient(qp, x, penalty)
+			next := make([]float64, n)
+			for i := range x {
+				next[i] = x[i] - step*grad[i]
+			}
+			x = projectBounds(next, qp.Lower, qp.Upper)
+		}
+		penalty *= penaltyGrowth
+	}
+	return x, nil
+}
+
+// qpGradient returns the gradient of qp's objective plus its equality and
+// inequality penalty terms at x, for the given penalty weight.
+func qpGradient(qp quadraticProgram, x []float64, penalty float64) []float64 {
+	n := len(x)
+	grad := make([]float64, n)
+	for j := 0; j < n; j++ {
+		grad[j] = qp.C[j]
+		for k := 0; k < n; k++ {
+			grad[j] += qp.Q[j][k] * x[k]
+		}
+	}
+
+	for i, row := range qp.A {
+		residual := dotProduct(row, x) - qp.B[i]
+		for j, aij := range row {
+			grad[j] += penalty * residual * aij
+		}
+	}
+
+	for i, row := range qp.IneqC {
+		v := dotProduct(row, x)
+		lowerViolation := math.Max(0, qp.IneqLower[i]-v)
+		upperViolation := math.Max(0, v-qp.IneqUpper[i])
+		if lowerViolation == 0 && upperViolation == 0 {
+			continue
+		}
+		for j, cij := range row {
+			grad[j] += penalty * cij * (upperViolation - lowerViolation)
+		}
+	}
+	return grad
+}
+
+// projectBounds clips x onto [lower, upper] component-wise.
+func projectBounds(x, lower, upper []float64) []float64 {
+	out := make([]float64, len(x))
+	for i, v := range x {
+		if v < lower[i] {
+			v = lower[i]
+		}
+		if v > upper[i] {
+			v = upper[i]
+		}
+		out[i] = v
+	}
+	return out
+}
+
+func dotProduct(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+// quadraticForm returns x^T * q * x.
+func quadraticForm(q [][]float64, x []float64) float64 {
+	var total float64
+	for i, row := range q {
+		total += x[i] * dotProduct(row, x)
+	}
+	return total
+}
+
+// weightBounds returns the per-symbol [lower, upper] weight bounds set via
+// PortfolioBuilder.WeightBounds, defaulting to [0, 1] for any symbol without
+// a configured entry.
+func (p *Portfolio) weightBounds() (lower, upper []float64) {
+	n := len(p.symbols)
+	lower = make([]float64, n)
+	upper = make([]float64, n)
+	for i := range lower {
+		lower[i] = 0
+		upper[i] = 1
+	}
+	for i, v := range p.weightLower {
+		if i < n {
+			lower[i] = v
+		}
+	}
+	for i, v := range p.weightUpper {
+		if i < n {
+			upper[i] = v
+		}
+	}
+	return lower, upper
+}
+
+// baseQP builds the quadratic program common to MinVariancePortfolio,
+// TangencyPortfolio and every EfficientFrontier point: minimize variance
+// subject to sum(weights)=1 plus whatever EqualityConstraints,
+// InequalityConstraints and WeightBounds were configured on the builder.
+func (p *Portfolio) baseQP(cov [][]float64) quadraticProgram {
+	n := len(p.symbols)
+	lower, upper := p.weightBounds()
+
+	a := append([][]float64{ones(n)}, p.equalityA...)
+	b := append([]float64{1}, p.equalityB...)
+
+	return quadraticProgram{
+		Q:         cov,
+		C:         make([]float64, n),
+		A:         a,
+		B:         b,
+		IneqC:     p.inequalityC,
+		IneqLower: p.inequalityLower,
+		IneqUpper: p.inequalityUpper,
+		Lower:     lower,
+		Upper:     upper,
+	}
+}
+
+func ones(n int) []float64 {
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = 1
+	}
+	return v
+}
+
+// copyReturnSeries returns a deep copy of series, or nil if series is nil.
+func copyReturnSeries(series [][]float64) [][]float64 {
+	if series == nil {
+		return nil
+	}
+	copied := make([][]float64, len(series))
+	for i, s := range series {
+		copied[i] = append([]float64(nil), s...)
+	}
+	return copied
+}
+
+// historicalReturnSeries returns the symbols' return series in TickerSymbols
+// order: Update/UpdateBatch's incrementally extended returnSeries if either
+// has ever been called on p, or else a fresh fetch of each symbol's own
+// price history via a single-symbol Ticker built over the Portfolio's
+// configured date range and interval. This mirrors RiskMetrics and the
+// technical-indicator subsystem's approach of computing analytics
+// client-side from FFI-fetched price history rather than from an
+// aggregated FFI endpoint, since covarianceAndMeans and scenarioMatrix both
+// need the per-asset return series that Portfolio.Returns does not expose.
+//
+// The cached series is deep-copied while liveMu is held for read, since
+// Update/UpdateBatch append to and overwrite p.returnSeries's rows under
+// liveMu.Lock(); returning the cached slices themselves would let a
+// concurrent Update race with the caller's read of them.
+func (p *Portfolio) historicalReturnSeries() ([][]float64, error) {
+	p.liveMu.RLock()
+	cached := copyReturnSeries(p.returnSeries)
+	p.liveMu.RUnlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	n := len(p.symbols)
+	returnSeries := make([][]float64, n)
+	for i, symbol := range p.symbols {
+		ticker, err := NewTickerBuilder().
+			Symbol(symbol).
+			StartDate(p.startDate).
+			EndDate(p.endDate).
+			Interval(p.interval).
+			Build()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build ticker for %q: %v", symbol, err)
+		}
+		candles, err := ticker.allCandles()
+		ticker.Free()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get candles for %q: %v", symbol, err)
+		}
+		returnSeries[i] = simpleReturns(closesOf(candles))
+	}
+
+	minLen := len(returnSeries[0])
+	for _, s := range returnSeries[1:] {
+		if len(s) < minLen {
+			minLen = len(s)
+		}
+	}
+	for i, s := range returnSeries {
+		returnSeries[i] = s[len(s)-minLen:]
+	}
+	return returnSeries, nil
+}
+
+// covarianceAndMeans returns the sample mean and covariance matrix of the
+// symbols' historical simple returns, in TickerSymbols order, via
+// historicalReturnSeries.
+func (p *Portfolio) covarianceAndMeans() ([]float64, [][]float64, error) {
+	returnSeries, err := p.historicalReturnSeries()
+	if err != nil {
+		return nil, nil, err
+	}
+	n := len(returnSeries)
+
+	means := make([]float64, n)
+	for i, s := range returnSeries {
+		var sum float64
+		for _, v := range s {
+			sum += v
+		}
+		means[i] = sum / float64(len(s))
+	}
+
+	cov := make([][]float64, n)
+	for i := range cov {
+		cov[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			c := sampleCovariance(returnSeries[i], returnSeries[j], means[i], means[j])
+			cov[i][j] = c
+			cov[j][i] = c
+		}
+	}
+	return means, cov, nil
+}
+
+// simpleReturns converts a series of closing prices into period-over-period
+// simple returns, one shorter than closes.
+func simpleReturns(closes []float64) []float64 {
+	if len(closes) < 2 {
+		return nil
+	}
+	returns := make([]float64, len(closes)-1)
+	for i := 1; i < len(closes); i++ {
+		returns[i-1] = closes[i]/closes[i-1] - 1
+	}
+	return returns
+}
+
+func sampleCovariance(a, b []float64, meanA, meanB float64) float64 {
+	n := len(a)
+	if n < 2 {
+		return 0
+	}
+	var sum float64
+	for i := range a {
+		sum += (a[i] - meanA) * (b[i] - meanB)
+	}
+	return sum / float64(n-1)
+}
+
+// weightsFromVector zips symbols and x into a map, dropping the ordering
+// the QP solver needs but callers don't.
+func weightsFromVector(symbols []string, x []float64) map[string]float64 {
+	weights := make(map[string]float64, len(symbols))
+	for i, symbol := range symbols {
+		weights[symbol] = x[i]
+	}
+	return weights
+}
+
+// MinVariancePortfolio solves the Portfolio's mean-variance QP for the
+// global minimum-variance weights, subject to sum(weights)=1 plus any
+// EqualityConstraints, InequalityConstraints and WeightBounds configured on
+// the builder, ignoring expected return entirely.
+//
+// Returns:
+//   - map[string]float64: The optimal weight for each symbol.
+//   - error: An error if the per-symbol price history can't be fetched or
+//     the QP has no variables.
+//
+// Example:
+//
+//	weights, err := portfolio.MinVariancePortfolio()
+//	if err != nil {
+//		fmt.Printf("Failed to solve for minimum variance: %v\n", err)
+//		return
+//	}
+//	fmt.Printf("Minimum-variance weights: %v\n", weights)
+func (p *Portfolio) MinVariancePortfolio() (map[string]float64, error) {
+	_, cov, err := p.covarianceAndMeans()
+	if err != nil {
+		return nil, err
+	}
+	x, err := solveQP(p.baseQP(cov))
+	if err != nil {
+		return nil, err
+	}
+	return weightsFromVector(p.symbols, x), nil
+}
+
+// targetReturnPortfolio solves the Portfolio's QP for the minimum-variance
+// weights whose expected return equals target, by adding a means^T x =
+// target row to baseQP's equality constraints.
+func (p *Portfolio) targetReturnPortfolio(means []float64, cov [][]float64, target float64) ([]float64, error) {
+	qp := p.baseQP(cov)
+	qp.A = append(qp.A, means)
+	qp.B = append(qp.B, target)
+	return solveQP(qp)
+}
+
+// EfficientFrontier traces nPoints portfolios along the efficient frontier
+// by sweeping a target-return equality constraint from the minimum-variance
+// portfolio's expected return up to the highest-returning symbol's expected
+// return, solving the QP (subject to any EqualityConstraints,
+// InequalityConstraints and WeightBounds configured on the builder) at each
+// point.
+//
+// Parameters:
+//   - nPoints: The number of frontier points to solve for (at least 2).
+//
+// Returns:
+//   - []FrontierPoint: Points ordered from lowest to highest expected return.
+//   - error: An error if nPoints < 2, or the per-symbol price history can't
+//     be fetched.
+//
+// Example:
+//
+//	frontier, err := portfolio.EfficientFrontier(20)
+//	if err != nil {
+//		fmt.Printf("Failed to trace efficient frontier: %v\n", err)
+//		return
+//	}
+//	fmt.Printf("Traced %d frontier points\n", len(frontier))
+func (p *Portfolio) EfficientFrontier(nPoints uint) ([]FrontierPoint, error) {
+	if nPoints < 2 {
+		return nil, fmt.Errorf("nPoints must be at least 2, got %d", nPoints)
+	}
+
+	means, cov, err := p.covarianceAndMeans()
+	if err != nil {
+		return nil, err
+	}
+
+	minVarWeights, err := solveQP(p.baseQP(cov))
+	if err != nil {
+		return nil, err
+	}
+	minReturn := dotProduct(means, minVarWeights)
+	maxReturn := maxOf(means)
+	if maxReturn < minReturn {
+		maxReturn = minReturn
+	}
+
+	points := make([]FrontierPoint, nPoints)
+	for i := 0; i < int(nPoints); i++ {
+		target := minReturn + (maxReturn-minReturn)*float64(i)/float64(nPoints-1)
+		weights, err := p.targetReturnPortfolio(means, cov, target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to solve frontier point %d (target return %.6f): %v", i, target, err)
+		}
+		points[i] = FrontierPoint{
+			Weights:        weightsFromVector(p.symbols, weights),
+			ExpectedReturn: dotProduct(means, weights),
+			Volatility:     math.Sqrt(quadraticForm(cov, weights)),
+		}
+	}
+	return points, nil
+}
+
+// TangencyPortfolio solves for the approximate maximum-Sharpe-ratio
+// (tangency) portfolio at riskFreeRate, by tracing EfficientFrontier and
+// keeping the point with the highest Sharpe ratio. Maximizing a ratio isn't
+// itself a quadratic program the way MinVariancePortfolio and each
+// EfficientFrontier point are, so it reuses the same solver across a sweep
+// instead of solving it directly.
+//
+// Parameters:
+//   - riskFreeRate: The risk-free rate, in the same per-period units as the
+//     symbols' returns (e.g. daily for a Portfolio built with Interval("1d")).
+//
+// Returns:
+//   - map[string]float64: The optimal weight for each symbol.
+//   - error: An error if the per-symbol price history can't be fetched.
+//
+// Example:
+//
+//	weights, err := portfolio.TangencyPortfolio(0.0001)
+//	if err != nil {
+//		fmt.Printf("Failed to solve for the tangency portfolio: %v\n", err)
+//		return
+//	}
+//	fmt.Printf("Tangency weights: %v\n", weights)
+func (p *Portfolio) TangencyPortfolio(riskFreeRate float64) (map[string]float64, error) {
+	const sweepPoints = 50
+	frontier, err := p.EfficientFrontier(sweepPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	best := frontier[0]
+	bestSharpe := frontierSharpeRatio(best, riskFreeRate)
+	for _, point := range frontier[1:] {
+		if s := frontierSharpeRatio(point, riskFreeRate); s > bestSharpe {
+			best, bestSharpe = point, s
+		}
+	}
+	return best.Weights, nil
+}
+
+// frontierSharpeRatio is (expected return - riskFreeRate) / volatility for a
+// single FrontierPoint, distinct from sharpeRatio's annualized-series form
+// since a FrontierPoint already holds a scalar expected return and
+// volatility rather than a return series.
+func frontierSharpeRatio(point FrontierPoint, riskFreeRate float64) float64 {
+	if point.Volatility == 0 {
+		return math.Inf(-1)
+	}
+	return (point.ExpectedReturn - riskFreeRate) / point.Volatility
+}
@@ -0,0 +1,73 @@
+package finalytics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSolveQPMinVarianceTwoUncorrelatedAssets(t *testing.T) {
+	// min (1/2) x^T Q x s.t. sum(x) = 1, 0 <= x <= 1, Q = diag(4, 1).
+	// The unconstrained minimizer of a diagonal quadratic under sum(x)=1 puts
+	// more weight on the asset with the smaller diagonal entry: x1/x2 = 1/4.
+	qp := quadraticProgram{
+		Q:     [][]float64{{4, 0}, {0, 1}},
+		C:     []float64{0, 0},
+		A:     [][]float64{{1, 1}},
+		B:     []float64{1},
+		Lower: []float64{0, 0},
+		Upper: []float64{1, 1},
+	}
+	x, err := solveQP(qp)
+	if err != nil {
+		t.Fatalf("solveQP returned error: %v", err)
+	}
+	if got := x[0] + x[1]; math.Abs(got-1) > 1e-2 {
+		t.Errorf("x[0]+x[1] = %v, want ~1", got)
+	}
+	if x[0] >= x[1] {
+		t.Errorf("x = %v, want less weight on the higher-variance asset (x[0] < x[1])", x)
+	}
+}
+
+func TestSolveQPRespectsBounds(t *testing.T) {
+	qp := quadraticProgram{
+		Q:     [][]float64{{1, 0}, {0, 1}},
+		C:     []float64{0, 0},
+		A:     [][]float64{{1, 1}},
+		B:     []float64{1},
+		Lower: []float64{0.3, 0},
+		Upper: []float64{1, 1},
+	}
+	x, err := solveQP(qp)
+	if err != nil {
+		t.Fatalf("solveQP returned error: %v", err)
+	}
+	if x[0] < 0.3-1e-9 {
+		t.Errorf("x[0] = %v, want >= 0.3 (Lower bound)", x[0])
+	}
+}
+
+func TestSolveQPNoVariables(t *testing.T) {
+	if _, err := solveQP(quadraticProgram{}); err == nil {
+		t.Error("solveQP(empty) = nil error, want an error for a program with no variables")
+	}
+}
+
+func TestProjectBounds(t *testing.T) {
+	got := projectBounds([]float64{-1, 0.5, 2}, []float64{0, 0, 0}, []float64{1, 1, 1})
+	want := []float64{0, 0.5, 1}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("projectBounds()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestQuadraticForm(t *testing.T) {
+	q := [][]float64{{2, 0}, {0, 3}}
+	x := []float64{2, 1}
+	// x^T Q x = 2*2*2 + 3*1*1 = 8 + 3 = 11.
+	if got := quadraticForm(q, x); got != 11 {
+		t.Errorf("quadraticForm(%v, %v) = %v, want 11", q, x, got)
+	}
+}
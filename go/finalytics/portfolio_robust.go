@@ -0,0 +1,295 @@
+package finalytics
+
+import (
+	"fmt"
+	"math"
+)
+
+// scenarioMatrix returns the Portfolio's scenario set of simulated returns,
+// one row per scenario and one column per symbol in TickerSymbols order:
+// PortfolioBuilder.Scenarios if configured, or the symbols' own historical
+// simple returns (via historicalReturnSeries) otherwise.
+func (p *Portfolio) scenarioMatrix() ([][]float64, error) {
+	if len(p.scenarios) > 0 {
+		return p.scenarios, nil
+	}
+	returnSeries, err := p.historicalReturnSeries()
+	if err != nil {
+		return nil, err
+	}
+	n := len(returnSeries)
+	t := len(returnSeries[0])
+	scenarios := make([][]float64, t)
+	for s := 0; s < t; s++ {
+		row := make([]float64, n)
+		for i := 0; i < n; i++ {
+			row[i] = returnSeries[i][s]
+		}
+		scenarios[s] = row
+	}
+	return scenarios, nil
+}
+
+// scenarioMeansAndCov returns the sample mean and covariance of the
+// Portfolio's scenario set, in TickerSymbols order: PortfolioBuilder.Scenarios
+// if configured, or covarianceAndMeans' historical estimate otherwise.
+func (p *Portfolio) scenarioMeansAndCov() ([]float64, [][]float64, error) {
+	if len(p.scenarios) == 0 {
+		return p.covarianceAndMeans()
+	}
+	means, cov := meanCovFromScenarios(p.scenarios)
+	return means, cov, nil
+}
+
+// meanCovFromScenarios returns the sample mean and covariance of a scenario
+// matrix (one row per scenario, one column per asset), the scenario-set
+// counterpart to covarianceAndMeans' historical-series calculation.
+func meanCovFromScenarios(scenarios [][]float64) ([]float64, [][]float64) {
+	s := len(scenarios)
+	n := len(scenarios[0])
+
+	means := make([]float64, n)
+	for _, row := range scenarios {
+		for j, v := range row {
+			means[j] += v
+		}
+	}
+	for j := range means {
+		means[j] /= float64(s)
+	}
+
+	cov := make([][]float64, n)
+	for i := range cov {
+		cov[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			var sum float64
+			for _, row := range scenarios {
+				sum += (row[i] - means[i]) * (row[j] - means[j])
+			}
+			c := sum / float64(s-1)
+			cov[i][j] = c
+			cov[j][i] = c
+		}
+	}
+	return means, cov
+}
+
+// RobustMaxSharpePortfolio solves for the portfolio maximizing worst-case
+// Sharpe ratio over PortfolioBuilder.UncertaintySet's uncertainty set on the
+// scenario mean estimate:
+//
+//	max (mean^T w - kappa * penalty(w)) / sqrt(w^T cov w)
+//
+// where penalty(w) is ||cov^(1/2) w|| = sqrt(w^T cov w) itself for an
+// "ellipsoidal" set, or sum(|w_i|) for a "box" set, and kappa defaults to 0
+// (no robustness) if UncertaintySet was never called. mean/cov come from
+// PortfolioBuilder.Scenarios if configured, or the symbols' own historical
+// returns otherwise. Maximizing a ratio isn't itself a quadratic program the
+// way MinVariancePortfolio and each EfficientFrontier point are, so, like
+// TangencyPortfolio, this traces a frontier of target-return QP solutions
+// and keeps the point with the highest robust Sharpe ratio.
+//
+// Returns:
+//   - map[string]float64: The optimal weight for each symbol.
+//   - error: An error if the scenario set can't be built, or no frontier
+//     point could be solved.
+//
+// Example:
+//
+//	weights, err := portfolio.RobustMaxSharpePortfolio()
+//	if err != nil {
+//		fmt.Printf("Failed to solve for the robust max-Sharpe portfolio: %v\n", err)
+//		return
+//	}
+//	fmt.Printf("Robust max-Sharpe weights: %v\n", weights)
+func (p *Portfolio) RobustMaxSharpePortfolio() (map[string]float64, error) {
+	means, cov, err := p.scenarioMeansAndCov()
+	if err != nil {
+		return nil, err
+	}
+
+	minVarWeights, err := solveQP(p.baseQP(cov))
+	if err != nil {
+		return nil, err
+	}
+	minReturn := dotProduct(means, minVarWeights)
+	maxReturn := maxOf(means)
+	if maxReturn < minReturn {
+		maxReturn = minReturn
+	}
+
+	const sweepPoints = 50
+	kappa := p.uncertaintyParams["kappa"]
+
+	var best []float64
+	bestSharpe := math.Inf(-1)
+	for i := 0; i < sweepPoints; i++ {
+		target := minReturn + (maxReturn-minReturn)*float64(i)/float64(sweepPoints-1)
+		weights, err := p.targetReturnPortfolio(means, cov, target)
+		if err != nil {
+			continue
+		}
+		if s := robustSharpeRatio(weights, means, cov, p.uncertaintyKind, kappa); s > bestSharpe {
+			best, bestSharpe = weights, s
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("failed to solve for a robust max-Sharpe portfolio")
+	}
+	return weightsFromVector(p.symbols, best), nil
+}
+
+// robustSharpeRatio is (mean^T w - kappa*penalty(w)) / volatility for kind's
+// uncertainty set, mirroring frontierSharpeRatio's plain form but with the
+// worst-case mean shaved by kappa's robustness penalty. kind == "box" uses
+// the L1 penalty sum(|w_i|); anything else (including an unset kind, whose
+// kappa is always 0) uses the ellipsoidal penalty, which is just volatility
+// itself.
+func robustSharpeRatio(w, means []float64, cov [][]float64, kind string, kappa float64) float64 {
+	vol := math.Sqrt(quadraticForm(cov, w))
+	if vol == 0 {
+		return math.Inf(-1)
+	}
+	var penalty float64
+	switch kind {
+	case "box":
+		for _, wi := range w {
+			penalty += math.Abs(wi)
+		}
+	default:
+		penalty = vol
+	}
+	return (dotProduct(means, w) - kappa*penalty) / vol
+}
+
+// cvarBound is a loose but finite bound on the Rockafellar-Uryasev LP's
+// alpha (VaR) and per-scenario u_s variables, which solveQP's projected
+// gradient needs in order to project onto a box; real simple returns never
+// approach it.
+const cvarBound = 10.0
+
+// zeroMatrix returns an n x n matrix of zeros, used for the CVaR LP's Q
+// term: the Rockafellar-Uryasev objective is linear in solveQP's [w, alpha,
+// u_s] variable vector, so it carries no quadratic term.
+func zeroMatrix(n int) [][]float64 {
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+	}
+	return m
+}
+
+// padRow returns row zero-extended to length n, for folding a constraint
+// defined over asset weights into a larger variable vector that also
+// carries the Rockafellar-Uryasev LP's alpha and u_s auxiliary variables.
+func padRow(row []float64, n int) []float64 {
+	padded := make([]float64, n)
+	copy(padded, row)
+	return padded
+}
+
+// CVaRMinPortfolio solves for the portfolio minimizing the Conditional
+// Value-at-Risk of PortfolioBuilder.Scenarios' scenario returns (or the
+// symbols' own historical returns if Scenarios is unset) at the Portfolio's
+// ConfidenceLevel, via the Rockafellar-Uryasev LP reformulation:
+//
+//	min alpha + (1/((1-beta)*S)) * sum(u_s)
+//	s.t. u_s >= -r_s^T w - alpha, u_s >= 0
+//
+// solved by folding alpha and the per-scenario u_s into solveQP's variable
+// vector alongside the asset weights w, subject to sum(w)=1 plus any
+// EqualityConstraints, InequalityConstraints and WeightBounds configured on
+// the builder.
+//
+// Returns:
+//   - map[string]float64: The optimal weight for each symbol.
+//   - error: An error if the scenario set can't be built or the LP has no
+//     scenarios.
+//
+// Example:
+//
+//	weights, err := portfolio.CVaRMinPortfolio()
+//	if err != nil {
+//		fmt.Printf("Failed to solve for the minimum-CVaR portfolio: %v\n", err)
+//		return
+//	}
+//	fmt.Printf("Minimum-CVaR weights: %v\n", weights)
+func (p *Portfolio) CVaRMinPortfolio() (map[string]float64, error) {
+	scenarios, err := p.scenarioMatrix()
+	if err != nil {
+		return nil, err
+	}
+	s := len(scenarios)
+	if s == 0 {
+		return nil, fmt.Errorf("scenario set has no scenarios")
+	}
+	n := len(p.symbols)
+
+	beta := p.confidenceLevel
+	if beta <= 0 || beta >= 1 {
+		beta = 0.95
+	}
+
+	// Variable vector: [w_1..w_n, alpha, u_1..u_s].
+	nVars := n + 1 + s
+	alphaIdx := n
+
+	weightLower, weightUpper := p.weightBounds()
+	lower := make([]float64, nVars)
+	upper := make([]float64, nVars)
+	copy(lower, weightLower)
+	copy(upper, weightUpper)
+	lower[alphaIdx], upper[alphaIdx] = -cvarBound, cvarBound
+	for i := alphaIdx + 1; i < nVars; i++ {
+		lower[i], upper[i] = 0, cvarBound
+	}
+
+	a := [][]float64{padRow(ones(n), nVars)}
+	b := []float64{1}
+	for i, row := range p.equalityA {
+		a = append(a, padRow(row, nVars))
+		b = append(b, p.equalityB[i])
+	}
+
+	var ineqC [][]float64
+	var ineqLower, ineqUpper []float64
+	for i, row := range p.inequalityC {
+		ineqC = append(ineqC, padRow(row, nVars))
+		ineqLower = append(ineqLower, p.inequalityLower[i])
+		ineqUpper = append(ineqUpper, p.inequalityUpper[i])
+	}
+	// u_s + alpha + r_s^T w >= 0, i.e. u_s >= -r_s^T w - alpha.
+	for sIdx, scenario := range scenarios {
+		row := padRow(scenario, nVars)
+		row[alphaIdx] = 1
+		row[alphaIdx+1+sIdx] = 1
+		ineqC = append(ineqC, row)
+		ineqLower = append(ineqLower, 0)
+		ineqUpper = append(ineqUpper, cvarBound)
+	}
+
+	c := make([]float64, nVars)
+	c[alphaIdx] = 1
+	uWeight := 1 / ((1 - beta) * float64(s))
+	for i := alphaIdx + 1; i < nVars; i++ {
+		c[i] = uWeight
+	}
+
+	x, err := solveQP(quadraticProgram{
+		Q:         zeroMatrix(nVars),
+		C:         c,
+		A:         a,
+		B:         b,
+		IneqC:     ineqC,
+		IneqLower: ineqLower,
+		IneqUpper: ineqUpper,
+		Lower:     lower,
+		Upper:     upper,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return weightsFromVector(p.symbols, x[:n]), nil
+}
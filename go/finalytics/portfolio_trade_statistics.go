@@ -0,0 +1,185 @@
+package finalytics
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tradeStatisticsRollingWindow is the number of trailing periods each point
+// of TradeStatisticsChart's rolling profit-factor line is computed over.
+const tradeStatisticsRollingWindow = 20
+
+// TradeStatistics holds a Portfolio's extended trade and performance
+// statistics, computed client-side from its periodic returns.
+type TradeStatistics struct {
+	ProfitFactor         float64
+	WinRate              float64
+	AvgWin               float64
+	AvgLoss              float64
+	WinLossRatio         float64 // AvgWin / |AvgLoss| (0 if there were no losses)
+	Expectancy           float64 // mean return per trade: WinRate*AvgWin + (1-WinRate)*AvgLoss
+	PayoffRatio          float64 // AvgWin / |AvgLoss|, the conventional name for WinLossRatio
+	CalmarRatio          float64
+	OmegaRatio           float64
+	UlcerIndex           float64
+	MaxConsecutiveWins   int
+	MaxConsecutiveLosses int
+}
+
+// computeTradeStatistics derives TradeStatistics from a slice of periodic
+// returns, treating mar as the minimum acceptable return for the Omega ratio
+// and periodsPerYear as the number of periods per year for annualization.
+func computeTradeStatistics(returns []float64, mar, periodsPerYear float64) TradeStatistics {
+	base := computeTradeStats(returns)
+	equity := equityFromReturns(returns)
+	maxDD := maxDrawdown(equity)
+
+	winLossRatio := 0.0
+	if base.AvgLoss != 0 {
+		winLossRatio = base.AvgWin / -base.AvgLoss
+	}
+
+	return TradeStatistics{
+		ProfitFactor:         base.ProfitFactor,
+		WinRate:              base.WinRate,
+		AvgWin:               base.AvgWin,
+		AvgLoss:              base.AvgLoss,
+		WinLossRatio:         winLossRatio,
+		Expectancy:           base.WinRate*base.AvgWin + (1-base.WinRate)*base.AvgLoss,
+		PayoffRatio:          winLossRatio,
+		CalmarRatio:          calmarRatio(annualizedReturn(returns, periodsPerYear), maxDD),
+		OmegaRatio:           omegaRatio(returns, mar),
+		UlcerIndex:           ulcerIndex(equity),
+		MaxConsecutiveWins:   base.MaxConsecutiveWins,
+		MaxConsecutiveLosses: base.MaxConsecutiveLosses,
+	}
+}
+
+// TradeStatistics computes extended trade and performance statistics for the
+// portfolio (profit factor, win rate, average win/loss, win/loss and payoff
+// ratios, expectancy, Calmar and Omega ratios, Ulcer index, and consecutive
+// win/loss streaks) from its daily returns series, at a minimum acceptable
+// return of 0.
+//
+// Returns:
+//   - *TradeStatistics: The computed trade statistics.
+//   - error: An error if the returns retrieval fails, or there are no returns
+//     to compute statistics from.
+//
+// Example:
+//
+//	stats, err := portfolio.TradeStatistics()
+//	if err != nil {
+//		fmt.Printf("Failed to get trade statistics: %v\n", err)
+//		return
+//	}
+//	fmt.Printf("Expectancy: %v\n", stats.Expectancy)
+func (p *Portfolio) TradeStatistics() (*TradeStatistics, error) {
+	returns, err := p.Returns()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get returns: %v", err)
+	}
+	values := returns.Col("portfolio_returns").Float()
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no returns available to compute trade statistics")
+	}
+	stats := computeTradeStatistics(values, 0, annualPeriods(p.interval))
+	return &stats, nil
+}
+
+// rollingProfitFactor returns the profit factor computed over each trailing
+// window-period slice of returns, one value per window ending at each index
+// from window-1 onward.
+func rollingProfitFactor(returns []float64, window int) []float64 {
+	if window < 1 || len(returns) < window {
+		return nil
+	}
+	rolling := make([]float64, len(returns)-window+1)
+	for i := range rolling {
+		rolling[i] = computeTradeStats(returns[i : i+window]).ProfitFactor
+	}
+	return rolling
+}
+
+// TradeStatisticsChart renders the portfolio's trade statistics as an HTML
+// summary table alongside a rolling profit-factor line chart, computed over
+// tradeStatisticsRollingWindow-period windows of its returns.
+//
+// Parameters:
+//   - height: The height of the chart (0 for default).
+//   - width: The width of the chart (0 for default).
+//
+// Returns:
+//   - HTML: An HTML object containing the trade statistics table and chart.
+//   - error: An error if the returns retrieval fails, or there are no returns
+//     to compute statistics from.
+//
+// Example:
+//
+//	chart, err := portfolio.TradeStatisticsChart(0, 0)
+//	if err != nil {
+//		fmt.Printf("Failed to get trade statistics chart: %v\n", err)
+//		return
+//	}
+//	chart.Show()
+func (p *Portfolio) TradeStatisticsChart(height, width uint) (HTML, error) {
+	returns, err := p.Returns()
+	if err != nil {
+		return HTML{}, fmt.Errorf("failed to get returns: %v", err)
+	}
+	values := returns.Col("portfolio_returns").Float()
+	if len(values) == 0 {
+		return HTML{}, fmt.Errorf("no returns available to chart trade statistics")
+	}
+
+	stats := computeTradeStatistics(values, 0, annualPeriods(p.interval))
+	rolling := rollingProfitFactor(values, tradeStatisticsRollingWindow)
+	labels := make([]string, len(rolling))
+	for i := range rolling {
+		labels[i] = strconv.Itoa(i + 1)
+	}
+
+	return HTML{Content: renderTradeStatisticsHTML(stats, labels, rolling, height, width)}, nil
+}
+
+// renderTradeStatisticsHTML builds a self-contained HTML document containing
+// a summary table of stats followed by an SVG line chart of the rolling
+// profit-factor series, in the same hand-rolled HTML style as
+// renderBacktestLineChart (no Plotly/JS chart library dependency).
+func renderTradeStatisticsHTML(stats TradeStatistics, labels []string, rolling []float64, height, width uint) string {
+	rows := [][2]string{
+		{"Profit Factor", fmt.Sprintf("%.4f", stats.ProfitFactor)},
+		{"Win Rate", fmt.Sprintf("%.4f", stats.WinRate)},
+		{"Avg Win", fmt.Sprintf("%.6f", stats.AvgWin)},
+		{"Avg Loss", fmt.Sprintf("%.6f", stats.AvgLoss)},
+		{"Win/Loss Ratio", fmt.Sprintf("%.4f", stats.WinLossRatio)},
+		{"Expectancy", fmt.Sprintf("%.6f", stats.Expectancy)},
+		{"Payoff Ratio", fmt.Sprintf("%.4f", stats.PayoffRatio)},
+		{"Calmar Ratio", fmt.Sprintf("%.4f", stats.CalmarRatio)},
+		{"Omega Ratio", fmt.Sprintf("%.4f", stats.OmegaRatio)},
+		{"Ulcer Index", fmt.Sprintf("%.4f", stats.UlcerIndex)},
+		{"Max Consecutive Wins", strconv.Itoa(stats.MaxConsecutiveWins)},
+		{"Max Consecutive Losses", strconv.Itoa(stats.MaxConsecutiveLosses)},
+	}
+
+	var table strings.Builder
+	table.WriteString("<table border=\"1\" cellpadding=\"4\" cellspacing=\"0\">\n")
+	for _, row := range rows {
+		fmt.Fprintf(&table, "<tr><td>%s</td><td>%s</td></tr>\n", row[0], row[1])
+	}
+	table.WriteString("</table>")
+
+	chart := "<p>not enough returns for a rolling profit-factor line</p>"
+	if len(rolling) > 0 {
+		chart = svgLineChart(labels, rolling, height, width)
+	}
+
+	return fmt.Sprintf(`<!DOCTYPE html><html><head><meta charset="utf-8"><title>Trade Statistics</title></head>
+<body>
+<h3>Trade Statistics</h3>
+%s
+<h3>Rolling Profit Factor (window=%d)</h3>
+%s
+</body></html>`, table.String(), tradeStatisticsRollingWindow, chart)
+}
@@ -0,0 +1,364 @@
+package finalytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+	"golang.org/x/time/rate"
+)
+
+// Provider is a pluggable source of quotes, candles, options chains, news,
+// and fundamentals for a Ticker, supplanting the Rust FFI's built-in Yahoo
+// Finance fetch for every Get* method rather than just OHLCV (compare
+// DataSource, which only covers TickerBuilder.TickerData/BenchmarkData).
+// Set one with TickerBuilder.Provider so users in regions without Yahoo
+// coverage, or needing SIP-licensed data, can still use the charting and
+// analytics layer built on top of it.
+type Provider interface {
+	Quote(ctx context.Context, symbol string) (map[string]any, error)
+	Candles(ctx context.Context, symbol, startDate, endDate, interval string) (dataframe.DataFrame, error)
+	OptionsChain(ctx context.Context, symbol string) (dataframe.DataFrame, error)
+	News(ctx context.Context, symbol string) (dataframe.DataFrame, error)
+	Fundamentals(ctx context.Context, symbol, statement, frequency string, formatted bool) (dataframe.DataFrame, error)
+}
+
+// ProviderCredentials holds the auth and transport knobs shared by the
+// built-in Provider implementations (PolygonProvider and FinnhubProvider):
+// an API key or OAuth bearer token, a rate-limit
+// budget, and a RoundTripper hook so tests can stub out the underlying HTTP
+// calls without hitting a real endpoint.
+type ProviderCredentials struct {
+	APIKey      string
+	OAuthToken  string
+	RateLimiter *rate.Limiter
+	Transport   http.RoundTripper
+}
+
+// client builds the *http.Client a Provider should issue requests through,
+// honoring Transport if set.
+func (c ProviderCredentials) client() *http.Client {
+	if c.Transport == nil {
+		return http.DefaultClient
+	}
+	return &http.Client{Transport: c.Transport}
+}
+
+// wait blocks until c.RateLimiter admits a request, or ctx is done. It is a
+// no-op when no limiter is configured.
+func (c ProviderCredentials) wait(ctx context.Context) error {
+	if c.RateLimiter == nil {
+		return nil
+	}
+	return c.RateLimiter.Wait(ctx)
+}
+
+// authenticate attaches the provider's credentials to req: a bearer token if
+// OAuthToken is set, otherwise an "apiKey"/"token" query parameter is left to
+// the caller (most REST quote providers expect the key in the query string,
+// not a header).
+func (c ProviderCredentials) authenticate(req *http.Request) {
+	if c.OAuthToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.OAuthToken)
+	}
+}
+
+// getJSON issues an authenticated, rate-limited GET against rawURL and
+// decodes the JSON response body into v.
+func (c ProviderCredentials) getJSON(ctx context.Context, rawURL string, v any) error {
+	if err := c.wait(ctx); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %v", err)
+	}
+	c.authenticate(req)
+	resp, err := c.client().Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %v", rawURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", rawURL, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %v", rawURL, err)
+	}
+	return nil
+}
+
+// PolygonProvider is a Provider backed by the Polygon.io REST API.
+// See https://polygon.io/docs/stocks.
+type PolygonProvider struct {
+	ProviderCredentials
+	// BaseURL overrides https://api.polygon.io; mainly useful for tests.
+	BaseURL string
+}
+
+func (p PolygonProvider) baseURL() string {
+	if p.BaseURL != "" {
+		return p.BaseURL
+	}
+	return "https://api.polygon.io"
+}
+
+type polygonLastTrade struct {
+	Results struct {
+		Price float64 `json:"p"`
+		Size  float64 `json:"s"`
+		Time  int64   `json:"t"` // unix nanos
+	} `json:"results"`
+}
+
+// Quote retrieves symbol's last trade from Polygon.
+func (p PolygonProvider) Quote(ctx context.Context, symbol string) (map[string]any, error) {
+	u := fmt.Sprintf("%s/v2/last/trade/%s?apiKey=%s", p.baseURL(), url.PathEscape(symbol), url.QueryEscape(p.APIKey))
+	var resp polygonLastTrade
+	if err := p.getJSON(ctx, u, &resp); err != nil {
+		return nil, fmt.Errorf("polygon: failed to get quote for %q: %w", symbol, err)
+	}
+	return map[string]any{
+		"symbol": symbol,
+		"price":  resp.Results.Price,
+		"size":   resp.Results.Size,
+		"time":   time.Unix(0, resp.Results.Time).UTC().Format(time.RFC3339),
+	}, nil
+}
+
+type polygonAggsResponse struct {
+	Results []struct {
+		Open   float64 `json:"o"`
+		High   float64 `json:"h"`
+		Low    float64 `json:"l"`
+		Close  float64 `json:"c"`
+		Volume float64 `json:"v"`
+		Time   int64   `json:"t"` // unix millis
+	} `json:"results"`
+}
+
+// Candles retrieves symbol's aggregate bars from Polygon between startDate
+// and endDate (both "2006-01-02"). interval maps to Polygon's multiplier/timespan
+// as: "1d" -> 1/day, "1h" -> 1/hour, "1m" -> 1/minute; any other value defaults to 1/day.
+func (p PolygonProvider) Candles(ctx context.Context, symbol, startDate, endDate, interval string) (dataframe.DataFrame, error) {
+	multiplier, timespan := polygonTimespan(interval)
+	u := fmt.Sprintf("%s/v2/aggs/ticker/%s/range/%d/%s/%s/%s?apiKey=%s",
+		p.baseURL(), url.PathEscape(symbol), multiplier, timespan,
+		url.PathEscape(startDate), url.PathEscape(endDate), url.QueryEscape(p.APIKey))
+	var resp polygonAggsResponse
+	if err := p.getJSON(ctx, u, &resp); err != nil {
+		return dataframe.DataFrame{}, fmt.Errorf("polygon: failed to get candles for %q: %w", symbol, err)
+	}
+
+	n := len(resp.Results)
+	timestamps := make([]string, n)
+	open, high, low, close, volume := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	for i, bar := range resp.Results {
+		timestamps[i] = time.UnixMilli(bar.Time).UTC().Format(time.RFC3339)
+		open[i], high[i], low[i], close[i], volume[i] = bar.Open, bar.High, bar.Low, bar.Close, bar.Volume
+	}
+	return dataframe.New(
+		series.New(timestamps, series.String, "timestamp"),
+		series.New(open, series.Float, "open"),
+		series.New(high, series.Float, "high"),
+		series.New(low, series.Float, "low"),
+		series.New(close, series.Float, "close"),
+		series.New(volume, series.Float, "volume"),
+	), nil
+}
+
+func polygonTimespan(interval string) (int, string) {
+	switch interval {
+	case "1h":
+		return 1, "hour"
+	case "1m":
+		return 1, "minute"
+	default:
+		return 1, "day"
+	}
+}
+
+// OptionsChain is not offered by Polygon's free tier in a shape this client
+// decodes; it returns an error so callers fall back to another Provider (or
+// the FFI's Yahoo-backed GetOptionsChain) for options data.
+func (p PolygonProvider) OptionsChain(ctx context.Context, symbol string) (dataframe.DataFrame, error) {
+	return dataframe.DataFrame{}, fmt.Errorf("polygon: options chain not supported for %q", symbol)
+}
+
+type polygonNewsResponse struct {
+	Results []struct {
+		Title       string `json:"title"`
+		PublishedAt string `json:"published_utc"`
+		Publisher   struct {
+			Name string `json:"name"`
+		} `json:"publisher"`
+	} `json:"results"`
+}
+
+// News retrieves symbol's recent news headlines from Polygon.
+func (p PolygonProvider) News(ctx context.Context, symbol string) (dataframe.DataFrame, error) {
+	u := fmt.Sprintf("%s/v2/reference/news?ticker=%s&apiKey=%s", p.baseURL(), url.QueryEscape(symbol), url.QueryEscape(p.APIKey))
+	var resp polygonNewsResponse
+	if err := p.getJSON(ctx, u, &resp); err != nil {
+		return dataframe.DataFrame{}, fmt.Errorf("polygon: failed to get news for %q: %w", symbol, err)
+	}
+
+	n := len(resp.Results)
+	titles, publishers, publishedAt := make([]string, n), make([]string, n), make([]string, n)
+	for i, item := range resp.Results {
+		titles[i], publishers[i], publishedAt[i] = item.Title, item.Publisher.Name, item.PublishedAt
+	}
+	return dataframe.New(
+		series.New(titles, series.String, "title"),
+		series.New(publishers, series.String, "publisher"),
+		series.New(publishedAt, series.String, "publishedAt"),
+	), nil
+}
+
+// Fundamentals is not offered by Polygon's free tier in a shape this client
+// decodes; it returns an error so callers fall back to another Provider (or
+// the FFI's Yahoo-backed GetIncomeStatement/GetBalanceSheet/GetCashflowStatement).
+func (p PolygonProvider) Fundamentals(ctx context.Context, symbol, statement, frequency string, formatted bool) (dataframe.DataFrame, error) {
+	return dataframe.DataFrame{}, fmt.Errorf("polygon: %s statement not supported for %q", statement, symbol)
+}
+
+// FinnhubProvider is a Provider backed by the Finnhub REST API.
+// See https://finnhub.io/docs/api.
+type FinnhubProvider struct {
+	ProviderCredentials
+	// BaseURL overrides https://finnhub.io/api/v1; mainly useful for tests.
+	BaseURL string
+}
+
+func (f FinnhubProvider) baseURL() string {
+	if f.BaseURL != "" {
+		return f.BaseURL
+	}
+	return "https://finnhub.io/api/v1"
+}
+
+type finnhubQuote struct {
+	Current       float64 `json:"c"`
+	Change        float64 `json:"d"`
+	ChangePercent float64 `json:"dp"`
+	High          float64 `json:"h"`
+	Low           float64 `json:"l"`
+	Open          float64 `json:"o"`
+	Time          int64   `json:"t"`
+}
+
+// Quote retrieves symbol's current quote from Finnhub.
+func (f FinnhubProvider) Quote(ctx context.Context, symbol string) (map[string]any, error) {
+	u := fmt.Sprintf("%s/quote?symbol=%s&token=%s", f.baseURL(), url.QueryEscape(symbol), url.QueryEscape(f.APIKey))
+	var resp finnhubQuote
+	if err := f.getJSON(ctx, u, &resp); err != nil {
+		return nil, fmt.Errorf("finnhub: failed to get quote for %q: %w", symbol, err)
+	}
+	return map[string]any{
+		"symbol":        symbol,
+		"price":         resp.Current,
+		"change":        resp.Change,
+		"changePercent": resp.ChangePercent,
+		"high":          resp.High,
+		"low":           resp.Low,
+		"open":          resp.Open,
+		"time":          time.Unix(resp.Time, 0).UTC().Format(time.RFC3339),
+	}, nil
+}
+
+type finnhubCandles struct {
+	Close  []float64 `json:"c"`
+	High   []float64 `json:"h"`
+	Low    []float64 `json:"l"`
+	Open   []float64 `json:"o"`
+	Status string    `json:"s"`
+	Time   []int64   `json:"t"`
+	Volume []float64 `json:"v"`
+}
+
+// Candles retrieves symbol's daily candles from Finnhub between startDate
+// and endDate ("2006-01-02"). interval is accepted for interface parity;
+// Finnhub's free tier only serves daily resolution.
+func (f FinnhubProvider) Candles(ctx context.Context, symbol, startDate, endDate, interval string) (dataframe.DataFrame, error) {
+	from, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return dataframe.DataFrame{}, fmt.Errorf("finnhub: invalid startDate %q: %w", startDate, err)
+	}
+	to, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return dataframe.DataFrame{}, fmt.Errorf("finnhub: invalid endDate %q: %w", endDate, err)
+	}
+
+	u := fmt.Sprintf("%s/stock/candle?symbol=%s&resolution=D&from=%d&to=%d&token=%s",
+		f.baseURL(), url.QueryEscape(symbol), from.Unix(), to.Unix(), url.QueryEscape(f.APIKey))
+	var resp finnhubCandles
+	if err := f.getJSON(ctx, u, &resp); err != nil {
+		return dataframe.DataFrame{}, fmt.Errorf("finnhub: failed to get candles for %q: %w", symbol, err)
+	}
+	if resp.Status != "ok" {
+		return dataframe.DataFrame{}, fmt.Errorf("finnhub: candles for %q returned status %q", symbol, resp.Status)
+	}
+
+	timestamps := make([]string, len(resp.Time))
+	for i, t := range resp.Time {
+		timestamps[i] = time.Unix(t, 0).UTC().Format(time.RFC3339)
+	}
+	return dataframe.New(
+		series.New(timestamps, series.String, "timestamp"),
+		series.New(resp.Open, series.Float, "open"),
+		series.New(resp.High, series.Float, "high"),
+		series.New(resp.Low, series.Float, "low"),
+		series.New(resp.Close, series.Float, "close"),
+		series.New(resp.Volume, series.Float, "volume"),
+	), nil
+}
+
+// OptionsChain is not implemented for Finnhub: it always returns an error
+// and never calls the Finnhub API.
+func (f FinnhubProvider) OptionsChain(ctx context.Context, symbol string) (dataframe.DataFrame, error) {
+	return dataframe.DataFrame{}, fmt.Errorf("finnhub: options chain not supported for %q", symbol)
+}
+
+type finnhubNewsItem struct {
+	Headline string `json:"headline"`
+	Source   string `json:"source"`
+	Datetime int64  `json:"datetime"`
+}
+
+// News retrieves symbol's company news from Finnhub for the month ending
+// today (UTC).
+func (f FinnhubProvider) News(ctx context.Context, symbol string) (dataframe.DataFrame, error) {
+	to := time.Now().UTC()
+	from := to.AddDate(0, -1, 0)
+	u := fmt.Sprintf("%s/company-news?symbol=%s&from=%s&to=%s&token=%s",
+		f.baseURL(), url.QueryEscape(symbol), from.Format("2006-01-02"), to.Format("2006-01-02"), url.QueryEscape(f.APIKey))
+	var items []finnhubNewsItem
+	if err := f.getJSON(ctx, u, &items); err != nil {
+		return dataframe.DataFrame{}, fmt.Errorf("finnhub: failed to get news for %q: %w", symbol, err)
+	}
+
+	n := len(items)
+	headlines, sources, publishedAt := make([]string, n), make([]string, n), make([]string, n)
+	for i, item := range items {
+		headlines[i] = item.Headline
+		sources[i] = item.Source
+		publishedAt[i] = time.Unix(item.Datetime, 0).UTC().Format(time.RFC3339)
+	}
+	return dataframe.New(
+		series.New(headlines, series.String, "title"),
+		series.New(sources, series.String, "publisher"),
+		series.New(publishedAt, series.String, "publishedAt"),
+	), nil
+}
+
+// Fundamentals is not offered by Finnhub's free tier in a shape this client
+// decodes; it returns an error so callers fall back to another Provider (or
+// the FFI's Yahoo-backed GetIncomeStatement/GetBalanceSheet/GetCashflowStatement).
+func (f FinnhubProvider) Fundamentals(ctx context.Context, symbol, statement, frequency string, formatted bool) (dataframe.DataFrame, error) {
+	return dataframe.DataFrame{}, fmt.Errorf("finnhub: %s statement not supported for %q", statement, symbol)
+}
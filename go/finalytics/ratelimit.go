@@ -0,0 +1,49 @@
+package finalytics
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// rateLimiterMu guards rateLimiter, which is nil (unlimited) until SetRateLimiter is called.
+var (
+	rateLimiterMu sync.RWMutex
+	rateLimiter   *rate.Limiter
+)
+
+// SetRateLimiter caps how often the package's *Context FFI methods
+// (GetPriceHistoryContext, GetNewsContext, Tickers.GetSummaryStatsContext,
+// etc.) issue requests, so bulk Tickers operations don't get 429'd by
+// upstream data providers. rps is the sustained requests-per-second rate and
+// burst is the maximum number of requests allowed to fire immediately. Pass
+// rps <= 0 to remove any previously configured limit.
+//
+// Parameters:
+//   - rps: The sustained requests-per-second rate.
+//   - burst: The maximum burst size.
+//
+// Example:
+//   finalytics.SetRateLimiter(5, 10)
+func SetRateLimiter(rps int, burst int) {
+	rateLimiterMu.Lock()
+	defer rateLimiterMu.Unlock()
+	if rps <= 0 {
+		rateLimiter = nil
+		return
+	}
+	rateLimiter = rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+// waitRateLimit blocks until the package's rate limiter (if any) admits a
+// request, or ctx is done. It is a no-op when no limiter has been configured.
+func waitRateLimit(ctx context.Context) error {
+	rateLimiterMu.RLock()
+	limiter := rateLimiter
+	rateLimiterMu.RUnlock()
+	if limiter == nil {
+		return nil
+	}
+	return limiter.Wait(ctx)
+}
@@ -0,0 +1,346 @@
+package finalytics
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/go-gota/gota/dataframe"
+)
+
+// FinancialStatement holds one reporting period's line items as a generic
+// metric map, since the underlying data source does not yet distinguish
+// income statement, balance sheet and cash flow line items individually.
+type FinancialStatement struct {
+	Period  string
+	Metrics map[string]float64
+}
+
+// OptionChain holds a single option contract row from an options chain.
+type OptionChain struct {
+	ContractSymbol    string
+	ExpirationDate    string
+	Type              string // "call" or "put"
+	Strike            float64
+	LastPrice         float64
+	Bid               float64
+	Ask               float64
+	Volume            float64
+	OpenInterest      float64
+	ImpliedVolatility float64
+}
+
+// NewsItem holds a single news headline.
+type NewsItem struct {
+	Title       string
+	Publisher   string
+	Link        string
+	PublishedAt string
+}
+
+// PerformanceReport holds typed performance statistics for an equal-weighted
+// basket of a Tickers' symbols, computed from their aligned daily returns.
+type PerformanceReport struct {
+	Sharpe           float64
+	Sortino          float64
+	Calmar           float64
+	Omega            float64
+	ProfitFactor     float64
+	WinRate          float64
+	MaxDrawdown      float64
+	VaR              float64
+	CVaR             float64
+	Beta             float64
+	Alpha            float64
+	TrackingError    float64
+	InformationRatio float64
+}
+
+// FinancialsReport holds a Tickers' income statement, balance sheet and cash
+// flow statement, one FinancialStatement per reporting period.
+type FinancialsReport struct {
+	Income   []FinancialStatement
+	Balance  []FinancialStatement
+	CashFlow []FinancialStatement
+}
+
+// OptionsReport holds the options chain across a Tickers' symbols.
+type OptionsReport struct {
+	Chains []OptionChain
+}
+
+// NewsReport holds recent news headlines across a Tickers' symbols.
+type NewsReport struct {
+	Items []NewsItem
+}
+
+// ReportData holds the typed data backing one section of Tickers.Report.
+// Exactly one field is populated, matching the requested reportType.
+type ReportData struct {
+	Performance *PerformanceReport
+	Financials  *FinancialsReport
+	Options     *OptionsReport
+	News        *NewsReport
+}
+
+// ReportData retrieves the structured data backing Tickers.Report(reportType),
+// so programmatic consumers don't have to scrape the rendered HTML.
+//
+// Parameters:
+//   - reportType: The type of report to retrieve (e.g., "performance", "financials", "options", "news").
+//
+// Returns:
+//   - *ReportData: The typed report data, with the field matching reportType populated.
+//   - error: An error if reportType is unrecognized or the underlying data retrieval fails.
+//
+// Example:
+//   data, err := tickers.ReportData("performance")
+//   if err != nil {
+//   	fmt.Printf("Failed to get report data: %v\n", err)
+//   	return
+//   }
+//   fmt.Printf("Sharpe: %v\n", data.Performance.Sharpe)
+func (t *Tickers) ReportData(reportType string) (*ReportData, error) {
+	switch reportType {
+	case "performance":
+		perf, err := t.performanceReport()
+		if err != nil {
+			return nil, err
+		}
+		return &ReportData{Performance: perf}, nil
+	case "financials":
+		fin, err := t.financialsReport()
+		if err != nil {
+			return nil, err
+		}
+		return &ReportData{Financials: fin}, nil
+	case "options":
+		opt, err := t.optionsReport()
+		if err != nil {
+			return nil, err
+		}
+		return &ReportData{Options: opt}, nil
+	case "news":
+		news, err := t.newsReport()
+		if err != nil {
+			return nil, err
+		}
+		return &ReportData{News: news}, nil
+	default:
+		return nil, fmt.Errorf("unsupported report type %q", reportType)
+	}
+}
+
+// performanceReport computes a PerformanceReport for an equal-weighted basket
+// of t.symbols from their aligned daily returns, against t.benchmarkSymbol
+// when one was set on the builder.
+func (t *Tickers) performanceReport() (*PerformanceReport, error) {
+	dates, returnsBySymbol, err := t.alignedReturns()
+	if err != nil {
+		return nil, err
+	}
+
+	basket := make([]float64, len(dates))
+	for _, symbol := range t.symbols {
+		series := returnsBySymbol[symbol]
+		for i, r := range series {
+			basket[i] += r / float64(len(t.symbols))
+		}
+	}
+
+	equity := equityFromReturns(basket)
+	periodsPerYear := annualPeriods(t.interval)
+	tradeStats := computeTradeStats(basket)
+
+	report := &PerformanceReport{
+		Sharpe:       sharpeRatio(basket, t.riskFreeRate, periodsPerYear),
+		Sortino:      sortinoRatio(basket, t.riskFreeRate/periodsPerYear),
+		Calmar:       calmarRatio(annualizedReturn(basket, periodsPerYear), maxDrawdown(equity)),
+		Omega:        omegaRatio(basket, 0),
+		ProfitFactor: tradeStats.ProfitFactor,
+		WinRate:      tradeStats.WinRate,
+		MaxDrawdown:  maxDrawdown(equity),
+		VaR:          valueAtRisk(basket, 0.95),
+		CVaR:         cvar(basket, 0.95),
+	}
+
+	if t.benchmarkSymbol != "" {
+		benchmark, err := t.GetTicker(t.benchmarkSymbol)
+		if err == nil {
+			history, err := benchmark.GetPriceHistory()
+			benchmark.Free()
+			if err == nil {
+				benchDates := history.Col("timestamp").Records()
+				benchCloses := history.Col("close").Float()
+				benchReturns, ok := alignBenchmarkReturns(dates, benchDates, benchCloses)
+				if ok {
+					report.Beta = beta(basket, benchReturns)
+					report.Alpha = alpha(basket, benchReturns, report.Beta)
+					report.TrackingError = trackingError(basket, benchReturns)
+					report.InformationRatio = informationRatio(basket, benchReturns, report.TrackingError)
+				}
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// financialsReport builds a FinancialsReport from the tickers' income
+// statement, balance sheet and cash flow statement.
+func (t *Tickers) financialsReport() (*FinancialsReport, error) {
+	income, err := t.GetIncomeStatement("annual", true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get income statement: %v", err)
+	}
+	balance, err := t.GetBalanceSheet("annual", true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance sheet: %v", err)
+	}
+	cashflow, err := t.GetCashflowStatement("annual", true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cash flow statement: %v", err)
+	}
+
+	return &FinancialsReport{
+		Income:   dataframeToStatements(income),
+		Balance:  dataframeToStatements(balance),
+		CashFlow: dataframeToStatements(cashflow),
+	}, nil
+}
+
+// dataframeToStatements converts a statement DataFrame into one
+// FinancialStatement per row, keyed by its "period" or "date" column (or,
+// failing that, "symbol"), with every other column as a metric.
+func dataframeToStatements(df dataframe.DataFrame) []FinancialStatement {
+	names := df.Names()
+	periodCol := ""
+	for _, candidate := range []string{"period", "date", "symbol"} {
+		for _, n := range names {
+			if n == candidate {
+				periodCol = n
+				break
+			}
+		}
+		if periodCol != "" {
+			break
+		}
+	}
+
+	statements := make([]FinancialStatement, df.Nrow())
+	for row := 0; row < df.Nrow(); row++ {
+		metrics := make(map[string]float64, len(names))
+		period := fmt.Sprintf("%d", row)
+		for _, name := range names {
+			if name == periodCol {
+				period = df.Col(name).Records()[row]
+				continue
+			}
+			col := df.Col(name)
+			if col.Err != nil {
+				continue
+			}
+			values := col.Float()
+			if row < len(values) && !math.IsNaN(values[row]) {
+				metrics[name] = values[row]
+			}
+		}
+		statements[row] = FinancialStatement{Period: period, Metrics: metrics}
+	}
+	return statements
+}
+
+// optionsReport builds an OptionsReport from GetOptionsChain.
+func (t *Tickers) optionsReport() (*OptionsReport, error) {
+	chain, err := t.GetOptionsChain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get options chain: %v", err)
+	}
+
+	chains := make([]OptionChain, chain.Nrow())
+	for row := 0; row < chain.Nrow(); row++ {
+		chains[row] = OptionChain{
+			ContractSymbol:    stringCellOrEmpty(chain, "contractSymbol", row),
+			ExpirationDate:    stringCellOrEmpty(chain, "expiration", row),
+			Type:              stringCellOrEmpty(chain, "type", row),
+			Strike:            floatCellOrZero(chain, "strike", row),
+			LastPrice:         floatCellOrZero(chain, "lastPrice", row),
+			Bid:               floatCellOrZero(chain, "bid", row),
+			Ask:               floatCellOrZero(chain, "ask", row),
+			Volume:            floatCellOrZero(chain, "volume", row),
+			OpenInterest:      floatCellOrZero(chain, "openInterest", row),
+			ImpliedVolatility: floatCellOrZero(chain, "impliedVolatility", row),
+		}
+	}
+	return &OptionsReport{Chains: chains}, nil
+}
+
+// newsReport builds a NewsReport from GetNews.
+func (t *Tickers) newsReport() (*NewsReport, error) {
+	news, err := t.GetNews()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get news: %v", err)
+	}
+
+	items := make([]NewsItem, news.Nrow())
+	for row := 0; row < news.Nrow(); row++ {
+		items[row] = NewsItem{
+			Title:       stringCellOrEmpty(news, "title", row),
+			Publisher:   stringCellOrEmpty(news, "publisher", row),
+			Link:        stringCellOrEmpty(news, "link", row),
+			PublishedAt: stringCellOrEmpty(news, "providerPublishTime", row),
+		}
+	}
+	return &NewsReport{Items: items}, nil
+}
+
+// stringCellOrEmpty returns df's (column, row) cell as a string, or "" if the
+// column does not exist or row is out of range.
+func stringCellOrEmpty(df dataframe.DataFrame, column string, row int) string {
+	col := df.Col(column)
+	if col.Err != nil {
+		return ""
+	}
+	records := col.Records()
+	if row >= len(records) {
+		return ""
+	}
+	return records[row]
+}
+
+// floatCellOrZero returns df's (column, row) cell as a float64, or 0 if the
+// column does not exist or row is out of range.
+func floatCellOrZero(df dataframe.DataFrame, column string, row int) float64 {
+	col := df.Col(column)
+	if col.Err != nil {
+		return 0
+	}
+	values := col.Float()
+	if row >= len(values) || math.IsNaN(values[row]) {
+		return 0
+	}
+	return values[row]
+}
+
+// alignBenchmarkReturns builds the benchmark's own period-over-period return
+// series (keyed by the date each return lands on) from benchmarkDates and
+// benchmarkCloses, then picks out the subset matching dates (the dates
+// returned alongside a symbol's returns by alignedReturns). It returns
+// ok=false if the benchmark is missing a return for any requested date.
+func alignBenchmarkReturns(dates, benchmarkDates []string, benchmarkCloses []float64) ([]float64, bool) {
+	benchmarkReturns := returnsFromCloses(benchmarkCloses)
+	returnByDate := make(map[string]float64, len(benchmarkReturns))
+	for i, r := range benchmarkReturns {
+		// returnsFromCloses drops the first close, so return i lands on benchmarkDates[i+1].
+		returnByDate[benchmarkDates[i+1]] = r
+	}
+
+	returns := make([]float64, len(dates))
+	for i, date := range dates {
+		r, ok := returnByDate[date]
+		if !ok {
+			return nil, false
+		}
+		returns[i] = r
+	}
+	return returns, true
+}
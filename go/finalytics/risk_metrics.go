@@ -0,0 +1,67 @@
+package finalytics
+
+import "fmt"
+
+// RiskMetrics holds a Ticker's typed risk and strategy-evaluation
+// statistics, computed client-side from its close price history.
+type RiskMetrics struct {
+	Sharpe              float64
+	Sortino             float64
+	Calmar              float64
+	Omega               float64
+	ProfitFactor        float64
+	WinRate             float64
+	MaxDrawdown         float64
+	MaxDrawdownDuration int // longest underwater streak, in periods
+	VaR                 float64 // historical Value-at-Risk at the ticker's configured confidence level
+}
+
+// computeRiskMetrics derives RiskMetrics from a ticker's close price history.
+func computeRiskMetrics(closes []float64, interval string, riskFreeRate, confidenceLevel float64) RiskMetrics {
+	periodsPerYear := annualPeriods(interval)
+	returns := returnsFromCloses(closes)
+	maxDD := maxDrawdown(closes)
+	tradeStats := computeTradeStats(returns)
+
+	return RiskMetrics{
+		Sharpe:              sharpeRatio(returns, riskFreeRate, periodsPerYear),
+		Sortino:             sortinoRatio(returns, riskFreeRate/periodsPerYear),
+		Calmar:              calmarRatio(annualizedReturn(returns, periodsPerYear), maxDD),
+		Omega:               omegaRatio(returns, 0),
+		ProfitFactor:        tradeStats.ProfitFactor,
+		WinRate:             tradeStats.WinRate,
+		MaxDrawdown:         maxDD,
+		MaxDrawdownDuration: maxDrawdownDuration(closes),
+		VaR:                 valueAtRisk(returns, confidenceLevel),
+	}
+}
+
+// RiskMetrics computes typed risk and strategy-evaluation statistics for the
+// ticker (Sharpe, Sortino, Calmar and Omega ratios, profit factor, win rate,
+// max drawdown and its duration, and Value-at-Risk at the ticker's
+// configured confidence level) from its close price history.
+//
+// Returns:
+//   - *RiskMetrics: The computed risk metrics.
+//   - error: An error if the price history retrieval fails, or there is not
+//     enough history to compute returns.
+//
+// Example:
+//   metrics, err := ticker.RiskMetrics()
+//   if err != nil {
+//   	fmt.Printf("Failed to get risk metrics: %v\n", err)
+//   	return
+//   }
+//   fmt.Printf("Sortino: %v\n", metrics.Sortino)
+func (t *Ticker) RiskMetrics() (*RiskMetrics, error) {
+	history, err := t.GetPriceHistory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price history: %v", err)
+	}
+	closes := history.Col("close").Float()
+	if len(closes) < 2 {
+		return nil, fmt.Errorf("not enough price history to compute risk metrics")
+	}
+	metrics := computeRiskMetrics(closes, t.interval, t.riskFreeRate, t.confidenceLevel)
+	return &metrics, nil
+}
@@ -0,0 +1,288 @@
+package finalytics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// defaultCorrelationWindow is the number of periods used by
+// CorrelationMatrixAt, which has no window parameter of its own.
+const defaultCorrelationWindow = 60
+
+// CorrelationMatrix holds the pairwise return correlation matrix for
+// t.symbols (in the same order) over a window ending on Date.
+type CorrelationMatrix struct {
+	Date    string
+	Symbols []string
+	Matrix  [][]float64
+}
+
+// alignedReturns fetches each symbol's close price history and returns the
+// period-over-period returns aligned on their shared timestamps (the
+// intersection of all symbols' dates, sorted ascending). Returns that cannot
+// be fetched for a symbol fail the whole call, since a correlation matrix
+// needs every symbol present at every point in the window.
+func (t *Tickers) alignedReturns() ([]string, map[string][]float64, error) {
+	closesByDate := make(map[string]map[string]float64, len(t.symbols))
+
+	for _, symbol := range t.symbols {
+		ticker, err := t.GetTicker(symbol)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get ticker %q: %v", symbol, err)
+		}
+		history, err := ticker.GetPriceHistory()
+		ticker.Free()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get price history for %q: %v", symbol, err)
+		}
+		dates := history.Col("timestamp").Records()
+		closes := history.Col("close").Float()
+		for i, date := range dates {
+			if closesByDate[date] == nil {
+				closesByDate[date] = make(map[string]float64, len(t.symbols))
+			}
+			closesByDate[date][symbol] = closes[i]
+		}
+	}
+
+	var commonDates []string
+	for date, bySymbol := range closesByDate {
+		if len(bySymbol) == len(t.symbols) {
+			commonDates = append(commonDates, date)
+		}
+	}
+	sort.Strings(commonDates)
+	if len(commonDates) < 2 {
+		return nil, nil, fmt.Errorf("fewer than 2 common dates across symbols %v", t.symbols)
+	}
+
+	returns := make(map[string][]float64, len(t.symbols))
+	for _, symbol := range t.symbols {
+		series := make([]float64, len(commonDates))
+		for i, date := range commonDates {
+			series[i] = closesByDate[date][symbol]
+		}
+		returns[symbol] = returnsFromCloses(series)
+	}
+	return commonDates[1:], returns, nil
+}
+
+// pearsonCorrelation returns the Pearson correlation coefficient between a
+// and b, which must be the same length. It returns 0 if either series has no
+// variance.
+func pearsonCorrelation(a, b []float64) float64 {
+	n := len(a)
+	if n == 0 {
+		return 0
+	}
+	var meanA, meanB float64
+	for i := range a {
+		meanA += a[i]
+		meanB += b[i]
+	}
+	meanA /= float64(n)
+	meanB /= float64(n)
+
+	var cov, varA, varB float64
+	for i := range a {
+		da, db := a[i]-meanA, b[i]-meanB
+		cov += da * db
+		varA += da * da
+		varB += db * db
+	}
+	if varA == 0 || varB == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varA*varB)
+}
+
+// correlationMatrixOf builds the symmetric Pearson correlation matrix for
+// symbols, given each symbol's return series over the window (all series
+// must be the same length).
+func correlationMatrixOf(symbols []string, windowReturns map[string][]float64) [][]float64 {
+	matrix := make([][]float64, len(symbols))
+	for i := range symbols {
+		matrix[i] = make([]float64, len(symbols))
+	}
+	for i, si := range symbols {
+		matrix[i][i] = 1
+		for j := i + 1; j < len(symbols); j++ {
+			sj := symbols[j]
+			corr := pearsonCorrelation(windowReturns[si], windowReturns[sj])
+			matrix[i][j] = corr
+			matrix[j][i] = corr
+		}
+	}
+	return matrix
+}
+
+// RollingCorrelation computes a time series of pairwise return correlation
+// matrices over sliding windows of window periods, stepping step periods
+// between windows, letting callers detect regime shifts such as correlation
+// breakdowns during drawdowns.
+//
+// Parameters:
+//   - window: The number of periods in each correlation window.
+//   - step: The number of periods to advance between successive windows.
+//
+// Returns:
+//   - []CorrelationMatrix: One matrix per window, ordered oldest to newest.
+//   - error: An error if price history retrieval fails or there is not
+//     enough aligned history for a single window.
+//
+// Example:
+//   matrices, err := tickers.RollingCorrelation(60, 20)
+func (t *Tickers) RollingCorrelation(window, step int) ([]CorrelationMatrix, error) {
+	if window < 2 {
+		return nil, fmt.Errorf("window must be at least 2, got %d", window)
+	}
+	if step < 1 {
+		return nil, fmt.Errorf("step must be at least 1, got %d", step)
+	}
+
+	dates, returns, err := t.alignedReturns()
+	if err != nil {
+		return nil, err
+	}
+	if len(dates) < window {
+		return nil, fmt.Errorf("only %d aligned periods available, need at least %d for window %d", len(dates), window, window)
+	}
+
+	var matrices []CorrelationMatrix
+	for end := window; end <= len(dates); end += step {
+		start := end - window
+		windowReturns := make(map[string][]float64, len(t.symbols))
+		for _, symbol := range t.symbols {
+			windowReturns[symbol] = returns[symbol][start:end]
+		}
+		matrices = append(matrices, CorrelationMatrix{
+			Date:    dates[end-1],
+			Symbols: t.symbols,
+			Matrix:  correlationMatrixOf(t.symbols, windowReturns),
+		})
+	}
+	return matrices, nil
+}
+
+// CorrelationMatrixAt returns the pairwise return correlation matrix for a
+// window of defaultCorrelationWindow periods ending on or before date, as a
+// plain [][]float64 (ordered to match t.symbols) so callers can feed it
+// directly into their own optimization or clustering pipelines.
+//
+// Parameters:
+//   - date: The date the window should end on or before, formatted "YYYY-MM-DD".
+//
+// Returns:
+//   - [][]float64: The correlation matrix, ordered to match t.symbols.
+//   - error: An error if price history retrieval fails, there is not enough
+//     aligned history, or no window ends on or before date.
+//
+// Example:
+//   matrix, err := tickers.CorrelationMatrixAt("2023-06-30")
+func (t *Tickers) CorrelationMatrixAt(date string) ([][]float64, error) {
+	matrices, err := t.RollingCorrelation(defaultCorrelationWindow, 1)
+	if err != nil {
+		return nil, err
+	}
+	var best *CorrelationMatrix
+	for i := range matrices {
+		if matrices[i].Date > date {
+			break
+		}
+		best = &matrices[i]
+	}
+	if best == nil {
+		return nil, fmt.Errorf("no correlation window ends on or before %q", date)
+	}
+	return best.Matrix, nil
+}
+
+// RollingReturnsChart renders a time series of rolling correlation matrices
+// as a heatmap-over-time HTML chart with one radio button per window to step
+// through them.
+// Windows step by max(window/4, 1) periods between frames.
+//
+// Parameters:
+//   - window: The number of periods in each correlation window.
+//
+// Returns:
+//   - HTML: An HTML object containing the animated heatmap chart.
+//   - error: An error if the underlying rolling correlation computation fails.
+//
+// Example:
+//   chart, err := tickers.RollingReturnsChart(60)
+//   if err != nil {
+//   	fmt.Printf("Failed to get rolling returns chart: %v\n", err)
+//   	return
+//   }
+//   chart.Show()
+func (t *Tickers) RollingReturnsChart(window int) (HTML, error) {
+	step := window / 4
+	if step < 1 {
+		step = 1
+	}
+	matrices, err := t.RollingCorrelation(window, step)
+	if err != nil {
+		return HTML{}, err
+	}
+	return HTML{Content: renderCorrelationHeatmapOverTime(matrices)}, nil
+}
+
+// renderCorrelationHeatmapOverTime builds a self-contained HTML table-based
+// heatmap for each matrix in matrices, with radio buttons to switch between
+// dates. Cells are shaded from red (-1) through white (0) to green (+1).
+func renderCorrelationHeatmapOverTime(matrices []CorrelationMatrix) string {
+	var frames, controls string
+	for i, m := range matrices {
+		id := fmt.Sprintf("frame-%d", i)
+		display := "none"
+		checked := ""
+		if i == 0 {
+			display = "block"
+			checked = "checked"
+		}
+		controls += fmt.Sprintf(
+			`<label><input type="radio" name="frame" onclick="document.querySelectorAll('.frame').forEach(f=>f.style.display='none');document.getElementById('%s').style.display='block';" %s>%s</label> `,
+			id, checked, m.Date)
+
+		var rows string
+		for ri, si := range m.Symbols {
+			rows += "<tr><th>" + si + "</th>"
+			for ci := range m.Symbols {
+				corr := m.Matrix[ri][ci]
+				rows += fmt.Sprintf(`<td style="background-color:%s">%s</td>`, correlationColor(corr), strconv.FormatFloat(corr, 'f', 2, 64))
+			}
+			rows += "</tr>"
+		}
+		var header string
+		for _, sj := range m.Symbols {
+			header += "<th>" + sj + "</th>"
+		}
+
+		frames += fmt.Sprintf(`<div class="frame" id="%s" style="display:%s"><table border="1" cellspacing="0" cellpadding="4"><tr><th></th>%s</tr>%s</table></div>`,
+			id, display, header, rows)
+	}
+
+	return fmt.Sprintf(`<!DOCTYPE html><html><head><meta charset="utf-8"><title>Rolling Correlation</title></head>
+<body><div>%s</div>%s</body></html>`, controls, frames)
+}
+
+// correlationColor maps a correlation coefficient in [-1, 1] to a red-white-
+// green CSS color, matching the red-through-green convention used elsewhere
+// in the package's charts for negative-to-positive values.
+func correlationColor(corr float64) string {
+	if corr < -1 {
+		corr = -1
+	}
+	if corr > 1 {
+		corr = 1
+	}
+	if corr < 0 {
+		gb := int(255 * (1 + corr))
+		return fmt.Sprintf("rgb(255,%d,%d)", gb, gb)
+	}
+	r := int(255 * (1 - corr))
+	return fmt.Sprintf("rgb(%d,255,%d)", r, r)
+}
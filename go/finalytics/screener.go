@@ -17,6 +17,18 @@ import (
 // It encapsulates a handle to the underlying C library for interacting with screener data.
 type Screener struct {
     handle C.ScreenerHandle
+
+    // params retains the arguments used to create the Screener so that it can be
+    // re-queried with a different offset/size, e.g. by ScreenerIterator.
+    params screenerParams
+}
+
+// screenerParams holds the arguments needed to re-issue finalytics_screener_new.
+type screenerParams struct {
+    quoteType      string
+    filters        []string
+    sortField      string
+    sortDescending bool
 }
 
 // NewScreener creates a new Screener instance with the given parameters.
@@ -82,7 +94,15 @@ func NewScreener(quoteType string, filters []string, sortField string, sortDesce
     if handle == nil {
         return nil, errors.New("failed to create Screener")
     }
-    return &Screener{handle: handle}, nil
+    return &Screener{
+        handle: handle,
+        params: screenerParams{
+            quoteType:      quoteType,
+            filters:        filters,
+            sortField:      sortField,
+            sortDescending: sortDescending,
+        },
+    }, nil
 }
 
 // Free releases the resources associated with the Screener.
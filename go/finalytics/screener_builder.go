@@ -0,0 +1,193 @@
+package finalytics
+
+import (
+    "encoding/json"
+    "fmt"
+)
+
+// screenerFilter is the JSON shape expected by NewScreener for a single filter criterion.
+type screenerFilter struct {
+    Operator string `json:"operator"`
+    Operands []any  `json:"operands"`
+}
+
+// ScreenerBuilder is used to construct a validated Screener instance using a fluent,
+// typed query DSL instead of hand-rolled filter JSON strings.
+// It validates metric names against AvailableMetrics before issuing any FFI call.
+type ScreenerBuilder struct {
+    quoteType      string
+    filters        []screenerFilter
+    pendingField   string
+    sortField      string
+    sortDescending bool
+    offset         uint
+    size           uint
+    err            error
+}
+
+// NewScreenerBuilder initializes a new ScreenerBuilder for the given quote type.
+//
+// Parameters:
+//   - quoteType: The type of financial instrument to screen (e.g., "EQUITY", "MUTUALFUND", "ETF", "INDEX", "FUTURE", "CRYPTOCURRENCY").
+//
+// Returns:
+//   - *ScreenerBuilder: A pointer to the initialized ScreenerBuilder.
+//
+// Example:
+//   builder := finalytics.NewScreenerBuilder("EQUITY")
+func NewScreenerBuilder(quoteType string) *ScreenerBuilder {
+    return &ScreenerBuilder{
+        quoteType: quoteType,
+        size:      10,
+    }
+}
+
+// Where selects the metric that the next comparison (Eq, Gte, Lte, Gt, Lt) applies to.
+// Between takes its own field and does not use the one selected here.
+//
+// Parameters:
+//   - field: The screener metric to filter on (e.g., "intradaymarketcap"). Must be a valid
+//     metric for the builder's quote type, see AvailableMetrics.
+//
+// Returns:
+//   - *ScreenerBuilder: The builder instance for method chaining.
+//
+// Example:
+//   builder.Where("intradaymarketcap").Gte(10e9)
+func (b *ScreenerBuilder) Where(field string) *ScreenerBuilder {
+    b.pendingField = field
+    return b
+}
+
+// And is an alias for Where, used to chain additional filter criteria for readability.
+//
+// Parameters:
+//   - field: The screener metric to filter on next.
+//
+// Returns:
+//   - *ScreenerBuilder: The builder instance for method chaining.
+//
+// Example:
+//   builder.Where("intradaymarketcap").Gte(10e9).And("exchange").Eq("NMS")
+func (b *ScreenerBuilder) And(field string) *ScreenerBuilder {
+    return b.Where(field)
+}
+
+func (b *ScreenerBuilder) addFilter(operator string, operands ...any) *ScreenerBuilder {
+    if b.err != nil {
+        return b
+    }
+    if b.pendingField == "" {
+        b.err = fmt.Errorf("no field selected: call Where before %s", operator)
+        return b
+    }
+    if !isValidMetric(b.quoteType, b.pendingField) {
+        b.err = fmt.Errorf("%q is not a valid screener metric for quote type %q", b.pendingField, b.quoteType)
+        return b
+    }
+    b.filters = append(b.filters, screenerFilter{
+        Operator: operator,
+        Operands: append([]any{b.pendingField}, operands...),
+    })
+    b.pendingField = ""
+    return b
+}
+
+// Eq adds an equality filter ("field == value") for the field set by the last Where/And call.
+func (b *ScreenerBuilder) Eq(value any) *ScreenerBuilder {
+    return b.addFilter("eq", value)
+}
+
+// Gte adds a greater-than-or-equal filter ("field >= value") for the field set by the last Where/And call.
+func (b *ScreenerBuilder) Gte(value any) *ScreenerBuilder {
+    return b.addFilter("gte", value)
+}
+
+// Lte adds a less-than-or-equal filter ("field <= value") for the field set by the last Where/And call.
+func (b *ScreenerBuilder) Lte(value any) *ScreenerBuilder {
+    return b.addFilter("lte", value)
+}
+
+// Gt adds a greater-than filter ("field > value") for the field set by the last Where/And call.
+func (b *ScreenerBuilder) Gt(value any) *ScreenerBuilder {
+    return b.addFilter("gt", value)
+}
+
+// Lt adds a less-than filter ("field < value") for the field set by the last Where/And call.
+func (b *ScreenerBuilder) Lt(value any) *ScreenerBuilder {
+    return b.addFilter("lt", value)
+}
+
+// Between adds a range filter ("low <= field <= high") on its field argument, replacing any
+// field selected by a preceding Where/And call.
+func (b *ScreenerBuilder) Between(field string, low, high float64) *ScreenerBuilder {
+    b.pendingField = field
+    return b.addFilter("btwn", low, high)
+}
+
+// SortBy sets the metric and direction to sort the screener results by.
+//
+// Parameters:
+//   - field: The screener metric to sort by (e.g., "intradaymarketcap").
+//   - descending: Whether to sort in descending order (true) or ascending order (false).
+//
+// Returns:
+//   - *ScreenerBuilder: The builder instance for method chaining.
+func (b *ScreenerBuilder) SortBy(field string, descending bool) *ScreenerBuilder {
+    if b.err != nil {
+        return b
+    }
+    if !isValidMetric(b.quoteType, field) {
+        b.err = fmt.Errorf("%q is not a valid screener metric for quote type %q", field, b.quoteType)
+        return b
+    }
+    b.sortField = field
+    b.sortDescending = descending
+    return b
+}
+
+// Page sets the offset and size of the result page to return.
+//
+// Parameters:
+//   - offset: The starting index of results to return (e.g., 0 to start from the beginning).
+//   - size: The maximum number of results to return (e.g., 10 for the top 10 results).
+//
+// Returns:
+//   - *ScreenerBuilder: The builder instance for method chaining.
+func (b *ScreenerBuilder) Page(offset, size uint) *ScreenerBuilder {
+    b.offset = offset
+    b.size = size
+    return b
+}
+
+// Build validates the accumulated filters and constructs the underlying Screener.
+//
+// Returns:
+//   - *Screener: A pointer to the initialized Screener object.
+//   - error: An error if a filter references an unknown metric, or if the Screener creation fails.
+//
+// Example:
+//   screener, err := finalytics.NewScreenerBuilder("EQUITY").
+//       Where("intradaymarketcap").Gte(10e9).
+//       And("exchange").Eq("NMS").
+//       Between("epsforward", 1.0, 5.0).
+//       SortBy("intradaymarketcap", true).
+//       Page(0, 10).
+//       Build()
+func (b *ScreenerBuilder) Build() (*Screener, error) {
+    if b.err != nil {
+        return nil, b.err
+    }
+    if b.pendingField != "" {
+        return nil, fmt.Errorf("dangling Where(%q) with no comparison applied", b.pendingField)
+    }
+    filters := make([]string, len(b.filters))
+    for i, f := range b.filters {
+        raw, err := json.Marshal(f)
+        if err != nil {
+            return nil, fmt.Errorf("failed to marshal filter: %v", err)
+        }
+        filters[i] = string(raw)
+    }
+    return NewScreener(b.quoteType, filters, b.sortField, b.sortDescending, b.offset, b.size)
+}
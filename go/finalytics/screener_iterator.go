@@ -0,0 +1,144 @@
+package finalytics
+
+import (
+    "context"
+
+    "github.com/go-gota/gota/dataframe"
+)
+
+// IteratorOption configures a ScreenerIterator.
+type IteratorOption func(*ScreenerIterator)
+
+// PageSize sets the number of results the ScreenerIterator requests from the FFI per page.
+// Defaults to 100 if not supplied.
+//
+// Example:
+//   iter := screener.Iterate(ctx, finalytics.PageSize(200))
+func PageSize(size uint) IteratorOption {
+    return func(it *ScreenerIterator) {
+        it.pageSize = size
+    }
+}
+
+// ScreenerIterator lazily pages through Screener results, re-issuing the underlying FFI
+// query in configurable page sizes instead of materializing the full result set at once.
+// It is not safe for concurrent use.
+type ScreenerIterator struct {
+    ctx     context.Context
+    params  screenerParams
+    pageSize uint
+    offset  uint
+    current *Screener
+    err     error
+    done    bool
+}
+
+// Iterate returns a ScreenerIterator that re-applies the Screener's filters and sort order
+// in pages of PageSize, starting from offset 0, until a short page is returned.
+//
+// Parameters:
+//   - ctx: A context.Context used to cancel iteration between pages.
+//   - opts: Optional IteratorOption values (e.g., PageSize).
+//
+// Returns:
+//   - *ScreenerIterator: An iterator over the Screener's matching instruments.
+//
+// Example:
+//   iter := screener.Iterate(ctx, finalytics.PageSize(200))
+//   for iter.Next() {
+//       batch, _ := iter.Overview()
+//       fmt.Println(batch)
+//   }
+//   if err := iter.Err(); err != nil {
+//       fmt.Printf("iteration failed: %v\n", err)
+//   }
+func (s *Screener) Iterate(ctx context.Context, opts ...IteratorOption) *ScreenerIterator {
+    it := &ScreenerIterator{
+        ctx:      ctx,
+        params:   s.params,
+        pageSize: 100,
+    }
+    for _, opt := range opts {
+        opt(it)
+    }
+    return it
+}
+
+// Next advances the iterator to the next page of results, returning false when the
+// context is cancelled, the FFI call fails, or a short page signals the end of results.
+func (it *ScreenerIterator) Next() bool {
+    if it.done || it.err != nil {
+        return false
+    }
+    if err := it.ctx.Err(); err != nil {
+        it.err = err
+        return false
+    }
+    if it.current != nil {
+        it.current.Free()
+        it.current = nil
+    }
+
+    screener, err := NewScreener(it.params.quoteType, it.params.filters, it.params.sortField, it.params.sortDescending, it.offset, it.pageSize)
+    if err != nil {
+        it.err = err
+        return false
+    }
+    symbols, err := screener.Symbols()
+    if err != nil {
+        screener.Free()
+        it.err = err
+        return false
+    }
+    if len(symbols) == 0 {
+        screener.Free()
+        it.done = true
+        return false
+    }
+
+    it.current = screener
+    it.offset += uint(len(symbols))
+    if uint(len(symbols)) < it.pageSize {
+        it.done = true // this is the last page, but still yield it
+    }
+    return true
+}
+
+// Err returns the error, if any, that caused iteration to stop early.
+func (it *ScreenerIterator) Err() error {
+    return it.err
+}
+
+// Symbols returns the ticker symbols for the current page.
+func (it *ScreenerIterator) Symbols() ([]string, error) {
+    if it.current == nil {
+        return nil, nil
+    }
+    return it.current.Symbols()
+}
+
+// Overview returns the overview DataFrame for the current page.
+func (it *ScreenerIterator) Overview() (dataframe.DataFrame, error) {
+    if it.current == nil {
+        return dataframe.DataFrame{}, nil
+    }
+    return it.current.Overview()
+}
+
+// Metrics returns the metrics DataFrame for the current page.
+func (it *ScreenerIterator) Metrics() (dataframe.DataFrame, error) {
+    if it.current == nil {
+        return dataframe.DataFrame{}, nil
+    }
+    return it.current.Metrics()
+}
+
+// Close releases the Screener handle backing the current page, if any.
+// Callers that exit iteration early (e.g. via a break) should call Close to avoid
+// leaking the last page's FFI handle.
+func (it *ScreenerIterator) Close() {
+    if it.current != nil {
+        it.current.Free()
+        it.current = nil
+    }
+}
@@ -0,0 +1,196 @@
+package finalytics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// SentimentResult is the scored sentiment of a single piece of text.
+type SentimentResult struct {
+	Score    float64  // in [-1, 1], negative to positive
+	Label    string   // "positive", "neutral", or "negative"
+	Entities []string // capitalized-word entities mentioned in the text
+}
+
+// SentimentProvider scores the sentiment of a headline or article body, for
+// use by Ticker.GetNewsWithSentiment. Implementations should be safe for
+// concurrent use.
+type SentimentProvider interface {
+	Score(text string) (SentimentResult, error)
+}
+
+// sentimentLabel classifies score into "positive", "neutral", or "negative"
+// using VADER's conventional +-0.05 compound-score thresholds.
+func sentimentLabel(score float64) string {
+	switch {
+	case score >= 0.05:
+		return "positive"
+	case score <= -0.05:
+		return "negative"
+	default:
+		return "neutral"
+	}
+}
+
+// extractEntities returns the distinct capitalized words in text (a simple,
+// dependency-free stand-in for named entity recognition), preserving order
+// of first appearance.
+func extractEntities(text string) []string {
+	var entities []string
+	seen := make(map[string]bool)
+	for _, word := range strings.Fields(text) {
+		trimmed := strings.TrimFunc(word, func(r rune) bool { return !unicode.IsLetter(r) })
+		if len(trimmed) < 2 || !unicode.IsUpper(rune(trimmed[0])) {
+			continue
+		}
+		if !seen[trimmed] {
+			seen[trimmed] = true
+			entities = append(entities, trimmed)
+		}
+	}
+	return entities
+}
+
+// vaderLexicon is a small, hand-picked subset of VADER's word-sentiment
+// lexicon (https://github.com/cjhutto/vaderSentiment), scored in [-4, 4].
+var vaderLexicon = map[string]float64{
+	"good": 1.9, "great": 3.1, "excellent": 3.6, "positive": 2.0, "beat": 2.0,
+	"beats": 2.0, "surge": 2.3, "surges": 2.3, "soar": 2.6, "soars": 2.6,
+	"gain": 1.5, "gains": 1.5, "profit": 1.8, "profits": 1.8, "growth": 1.6,
+	"strong": 1.9, "record": 1.5, "upgrade": 2.0, "upgraded": 2.0, "rally": 2.0,
+	"bullish": 2.3, "win": 1.8, "wins": 1.8, "success": 2.0,
+	"bad": -1.9, "poor": -1.8, "weak": -1.7, "negative": -2.0, "miss": -1.9,
+	"misses": -1.9, "plunge": -2.9, "plunges": -2.9, "slump": -2.1, "slumps": -2.1,
+	"loss": -2.0, "losses": -2.0, "decline": -1.6, "declines": -1.6,
+	"downgrade": -2.0, "downgraded": -2.0, "crash": -3.1, "crashes": -3.1,
+	"bearish": -2.3, "lawsuit": -1.6, "fraud": -3.2, "layoffs": -2.2,
+	"bankruptcy": -3.4, "recall": -1.8, "scandal": -2.8,
+}
+
+// VADERLexiconSentiment is a local, lexicon-based SentimentProvider modeled
+// on VADER's compound scoring: the mean of each matched word's lexicon
+// score, normalized into [-1, 1]. It makes no network calls.
+type VADERLexiconSentiment struct{}
+
+// Score implements SentimentProvider using vaderLexicon, with no network access.
+func (VADERLexiconSentiment) Score(text string) (SentimentResult, error) {
+	words := strings.Fields(text)
+	var sum float64
+	var matched int
+	for _, word := range words {
+		key := strings.ToLower(strings.TrimFunc(word, func(r rune) bool { return !unicode.IsLetter(r) }))
+		if v, ok := vaderLexicon[key]; ok {
+			sum += v
+			matched++
+		}
+	}
+
+	score := 0.0
+	if matched > 0 {
+		score = sum / (float64(matched) * 4) // normalize by the lexicon's +-4 scale
+		if score > 1 {
+			score = 1
+		}
+		if score < -1 {
+			score = -1
+		}
+	}
+
+	return SentimentResult{
+		Score:    score,
+		Label:    sentimentLabel(score),
+		Entities: extractEntities(text),
+	}, nil
+}
+
+// HTTPSentimentProvider scores text via an HTTP POST to a user-configured
+// LLM/inference endpoint. It posts {"text": text} and expects a JSON
+// response shaped like SentimentResult: {"score":..., "label":..., "entities":[...]}.
+type HTTPSentimentProvider struct {
+	URL    string
+	Client *http.Client // defaults to http.DefaultClient if nil
+}
+
+// Score implements SentimentProvider by posting text to p.URL.
+func (p HTTPSentimentProvider) Score(text string) (SentimentResult, error) {
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return SentimentResult{}, fmt.Errorf("failed to encode sentiment request: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, p.URL, bytes.NewReader(body))
+	if err != nil {
+		return SentimentResult{}, fmt.Errorf("failed to build sentiment request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return SentimentResult{}, fmt.Errorf("failed to call sentiment endpoint %s: %v", p.URL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return SentimentResult{}, fmt.Errorf("sentiment endpoint %s returned status %d", p.URL, resp.StatusCode)
+	}
+
+	var result SentimentResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return SentimentResult{}, fmt.Errorf("failed to decode sentiment response from %s: %v", p.URL, err)
+	}
+	return result, nil
+}
+
+// GetNewsWithSentiment retrieves the ticker's news and augments it with
+// "sentiment_score", "sentiment_label", and "entities" columns, scored by
+// the ticker's configured SentimentProvider (VADERLexiconSentiment by
+// default). A headline the provider fails to score gets a neutral,
+// zero-valued row rather than failing the whole call.
+//
+// Returns:
+//   - dataframe.DataFrame: The news DataFrame with sentiment columns appended.
+//   - error: An error if the news retrieval fails.
+//
+// Example:
+//   news, err := ticker.GetNewsWithSentiment()
+func (t *Ticker) GetNewsWithSentiment() (dataframe.DataFrame, error) {
+	news, err := t.GetNews()
+	if err != nil {
+		return news, fmt.Errorf("failed to get news: %v", err)
+	}
+
+	provider := t.sentimentProvider
+	if provider == nil {
+		provider = VADERLexiconSentiment{}
+	}
+
+	titles := news.Col("title").Records()
+	scores := make([]float64, len(titles))
+	labels := make([]string, len(titles))
+	entities := make([]string, len(titles))
+
+	for i, title := range titles {
+		result, err := provider.Score(title)
+		if err != nil {
+			labels[i] = sentimentLabel(0)
+			continue
+		}
+		scores[i] = result.Score
+		labels[i] = result.Label
+		entities[i] = strings.Join(result.Entities, ", ")
+	}
+
+	return news.Mutate(series.New(scores, series.Float, "sentiment_score")).
+		Mutate(series.New(labels, series.String, "sentiment_label")).
+		Mutate(series.New(entities, series.String, "entities")), nil
+}
@@ -0,0 +1,65 @@
+package finalytics
+
+import (
+	"context"
+	"time"
+)
+
+// QuoteUpdate is a single update delivered by Ticker.Subscribe or Tickers.Subscribe.
+type QuoteUpdate struct {
+	Symbol string
+	Quote  map[string]any
+	Err    error
+}
+
+// Subscribe polls the ticker's quote every interval and delivers each result on
+// the returned channel until ctx is cancelled, at which point the channel is closed.
+// This is a polling-based stream; it re-fetches the quote over the existing FFI
+// rather than opening a persistent connection.
+//
+// Parameters:
+//   - ctx: A context.Context used to stop the subscription.
+//   - interval: The polling interval between quote checks.
+//
+// Returns:
+//   - <-chan QuoteUpdate: A channel of quote updates, closed when ctx is done.
+//
+// Example:
+//   ctx, cancel := context.WithCancel(context.Background())
+//   defer cancel()
+//   for update := range ticker.Subscribe(ctx, 5*time.Second) {
+//       if update.Err != nil {
+//           fmt.Printf("quote error: %v\n", update.Err)
+//           continue
+//       }
+//       fmt.Printf("%s: %v\n", update.Symbol, update.Quote)
+//   }
+func (t *Ticker) Subscribe(ctx context.Context, interval time.Duration) <-chan QuoteUpdate {
+	out := make(chan QuoteUpdate)
+	go func() {
+		defer close(out)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				quote, err := t.GetQuote()
+				update := QuoteUpdate{Quote: quote, Err: err}
+				if err == nil {
+					if symbol, ok := quote["symbol"].(string); ok {
+						update.Symbol = symbol
+					}
+				}
+				select {
+				case out <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
@@ -0,0 +1,126 @@
+package finalytics
+
+/*
+#include <finalytics.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+    "fmt"
+    "unsafe"
+)
+
+// SearchOptions narrows a SearchSymbols query to a subset of instruments.
+type SearchOptions struct {
+    AssetClass AssetClass // if empty, matches any asset class
+    Exchange   string     // if empty, matches any exchange
+    Country    string     // if empty, matches any country
+    Limit      uint       // if 0, defaults to 10
+}
+
+// SymbolMatch is a single candidate returned by SearchSymbols, ranked by
+// Score (higher is a better match for the query).
+type SymbolMatch struct {
+    Symbol     string
+    Name       string
+    Exchange   string
+    AssetClass AssetClass
+    Currency   string
+    Score      float64
+}
+
+// SearchSymbols looks up ticker symbols by company name or partial symbol,
+// for use before Symbols() when the exact symbol isn't known up front.
+//
+// Parameters:
+//   - query: A company name or partial symbol (e.g., "apple" or "AAP").
+//   - opts: SearchOptions narrowing the results by asset class, exchange, country, and result count.
+//
+// Returns:
+//   - []SymbolMatch: The matching symbols, ordered by descending Score.
+//   - error: An error if the search fails.
+//
+// Example:
+//   matches, err := finalytics.SearchSymbols("apple", finalytics.SearchOptions{Limit: 5})
+func SearchSymbols(query string, opts SearchOptions) ([]SymbolMatch, error) {
+    limit := opts.Limit
+    if limit == 0 {
+        limit = 10
+    }
+
+    cQuery := C.CString(query)
+    defer C.free(unsafe.Pointer(cQuery))
+    cAssetClass := C.CString(string(opts.AssetClass))
+    defer C.free(unsafe.Pointer(cAssetClass))
+    cExchange := C.CString(opts.Exchange)
+    defer C.free(unsafe.Pointer(cExchange))
+    cCountry := C.CString(opts.Country)
+    defer C.free(unsafe.Pointer(cCountry))
+
+    var cOutput *C.char
+    result := C.finalytics_search_symbols(cQuery, cAssetClass, cExchange, cCountry, C.uint(limit), &cOutput)
+    if result != 0 {
+        return nil, fmt.Errorf("failed to search symbols for %q: error code %d", query, result)
+    }
+
+    df, err := parseJSONToDataFrame(cOutput)
+    if err != nil {
+        return nil, err
+    }
+
+    symbols := df.Col("symbol").Records()
+    names := df.Col("name").Records()
+    exchanges := df.Col("exchange").Records()
+    assetClasses := df.Col("assetClass").Records()
+    currencies := df.Col("currency").Records()
+    scores := df.Col("score").Float()
+
+    matches := make([]SymbolMatch, len(symbols))
+    for i, symbol := range symbols {
+        matches[i] = SymbolMatch{
+            Symbol:     symbol,
+            Name:       stringAt(names, i),
+            Exchange:   stringAt(exchanges, i),
+            AssetClass: AssetClass(stringAt(assetClasses, i)),
+            Currency:   stringAt(currencies, i),
+            Score:      floatAt(scores, i),
+        }
+    }
+    return matches, nil
+}
+
+// SymbolsFromSearch resolves query to a single ticker symbol via
+// SearchSymbols and sets it as the TickersBuilder's Symbols, sparing callers
+// a manual search-then-Symbols round trip. It fails if the search returns no
+// match or more than one match, since either case means query did not
+// unambiguously identify one symbol.
+//
+// Parameters:
+//   - query: A company name or partial symbol (e.g., "apple").
+//   - opts: SearchOptions narrowing the search by asset class, exchange, country, and result count.
+//
+// Returns:
+//   - *TickersBuilder: The builder instance for method chaining.
+//   - error: An error if the search fails, or matches zero or more than one symbol.
+//
+// Example:
+//   builder, err := finalytics.NewTickersBuilder().SymbolsFromSearch("apple", finalytics.SearchOptions{AssetClass: finalytics.EquityAssetClass})
+func (b *TickersBuilder) SymbolsFromSearch(query string, opts SearchOptions) (*TickersBuilder, error) {
+    matches, err := SearchSymbols(query, opts)
+    if err != nil {
+        return nil, err
+    }
+    if len(matches) == 0 {
+        return nil, fmt.Errorf("no symbol found matching %q", query)
+    }
+    if len(matches) > 1 {
+        symbols := make([]string, len(matches))
+        for i, m := range matches {
+            symbols[i] = m.Symbol
+        }
+        return nil, fmt.Errorf("query %q is ambiguous, matched symbols %v", query, symbols)
+    }
+
+    b.symbols = []string{matches[0].Symbol}
+    return b, nil
+}
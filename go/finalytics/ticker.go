@@ -6,8 +6,10 @@ package finalytics
 */
 import "C"
 import (
+    "context"
     "errors"
     "fmt"
+    "time"
     "unsafe"
 
     "github.com/go-gota/gota/dataframe"
@@ -16,21 +18,42 @@ import (
 // Ticker represents a financial ticker with methods for retrieving financial data and analytics.
 // It encapsulates a handle to the underlying C library for interacting with financial data.
 type Ticker struct {
-    handle C.TickerHandle
+    handle            C.TickerHandle
+    interval          string
+    symbol            string
+    assetClass        AssetClass
+    startDate         string
+    endDate           string
+    confidenceLevel   float64
+    riskFreeRate      float64
+    dividendYield     float64
+    streamProvider    QuoteStream
+    sentimentProvider SentimentProvider
+    provider          Provider
+    indicators        []Indicator
+
+    cache *diskCache
 }
 
 // TickerBuilder is used to construct a Ticker instance using the builder pattern.
 // It allows for fluent configuration of the Ticker's parameters before creation.
 type TickerBuilder struct {
-    symbol          string
-    startDate       string
-    endDate         string
-    interval        string
-    benchmarkSymbol string
-    confidenceLevel float64
-    riskFreeRate    float64
-    tickerData      *dataframe.DataFrame
-    benchmarkData   *dataframe.DataFrame
+    symbol             string
+    assetClass         AssetClass
+    startDate          string
+    endDate            string
+    interval           string
+    benchmarkSymbol    string
+    confidenceLevel    float64
+    riskFreeRate       float64
+    dividendYield      float64
+    tickerData         *dataframe.DataFrame
+    benchmarkData      *dataframe.DataFrame
+    streamProviderName string
+    sentimentProvider  SentimentProvider
+    provider           Provider
+    cacheDir           string
+    cacheTTL           time.Duration
 }
 
 // NewTickerBuilder initializes a new TickerBuilder with default values.
@@ -41,8 +64,12 @@ type TickerBuilder struct {
 //   - startDate: ""
 //   - endDate: ""
 //   - benchmarkSymbol: ""
+//   - dividendYield: 0
 //   - tickerData: nil
 //   - benchmarkData: nil
+//   - streamProviderName: "yahoo"
+//   - cacheDir: "" (caching disabled)
+//   - assetClass: EquityAssetClass
 //
 // Returns:
 //   - *TickerBuilder: A pointer to the initialized TickerBuilder.
@@ -61,17 +88,116 @@ type TickerBuilder struct {
 //   }
 func NewTickerBuilder() *TickerBuilder {
     return &TickerBuilder{
-        confidenceLevel: 0.95,
-        riskFreeRate:    0.02,
-        interval:        "1d",
-        startDate:       "",
-        endDate:         "",
-        benchmarkSymbol: "",
-        tickerData:      nil,
-        benchmarkData:   nil,
+        confidenceLevel:    0.95,
+        riskFreeRate:       0.02,
+        interval:           "1d",
+        startDate:          "",
+        endDate:            "",
+        benchmarkSymbol:    "",
+        tickerData:         nil,
+        benchmarkData:      nil,
+        streamProviderName: "yahoo",
+        assetClass:         EquityAssetClass,
     }
 }
 
+// AssetClass sets the asset class of the ticker, enabling validation of the
+// symbol's naming convention and routing Ticker.Candles/CandlesDataFrame to
+// the fields that asset class actually reports (e.g. mutual funds and
+// indices have no intraday volume).
+//
+// Parameters:
+//   - assetClass: The typed AssetClass (e.g. finalytics.MutualFundAssetClass).
+//
+// Returns:
+//   - *TickerBuilder: The builder instance for method chaining.
+//
+// Example:
+//   builder := finalytics.NewTickerBuilder().Symbol("VTSAX").AssetClass(finalytics.MutualFundAssetClass)
+func (b *TickerBuilder) AssetClass(assetClass AssetClass) *TickerBuilder {
+    b.assetClass = assetClass
+    return b
+}
+
+// Provider overrides the quotes, candles, options chain, news, and
+// fundamentals Ticker serves from, in place of the FFI's built-in Yahoo
+// Finance fetch. Once set, GetQuote, GetPriceHistory, GetOptionsChain,
+// GetNews and GetIncomeStatement/GetBalanceSheet/GetCashflowStatement are
+// served from p instead, as are the Go-side methods built on GetPriceHistory
+// (Candles, CandlesDataFrame, IndicatorValues and the ratios PerformanceStats
+// adds). The charts, reports and statistics the FFI renders itself (e.g.
+// PerformanceChart, OptionsChart, NewsSentimentChart, Report and the base
+// PerformanceStats figures), GetPriceHistoryArrow and the *Context methods
+// still use the FFI's own Yahoo Finance data. A Provider method returning an
+// error (e.g. PolygonProvider.OptionsChain, which isn't offered on its free
+// tier) surfaces as that Get* method's error rather than falling back to the FFI.
+//
+// Parameters:
+//   - p: The Provider to fetch from.
+//
+// Returns:
+//   - *TickerBuilder: The builder instance for method chaining.
+//
+// Example:
+//   builder := finalytics.NewTickerBuilder().
+//       Symbol("AAPL").
+//       Provider(finalytics.PolygonProvider{ProviderCredentials: finalytics.ProviderCredentials{APIKey: "..."}})
+func (b *TickerBuilder) Provider(p Provider) *TickerBuilder {
+    b.provider = p
+    return b
+}
+
+// DividendYield sets the continuously-compounded annual dividend yield used
+// by PriceOption, ImpliedVolatility, and OptionGreeks.
+//
+// Parameters:
+//   - dividendYield: The annual dividend yield as a fraction (e.g. 0.015 for 1.5%).
+//
+// Returns:
+//   - *TickerBuilder: The builder instance for method chaining.
+//
+// Example:
+//   builder := finalytics.NewTickerBuilder().DividendYield(0.015)
+func (b *TickerBuilder) DividendYield(dividendYield float64) *TickerBuilder {
+    b.dividendYield = dividendYield
+    return b
+}
+
+// SentimentProvider sets the provider used by the built Ticker's
+// GetNewsWithSentiment. Defaults to VADERLexiconSentiment (a local,
+// network-free lexicon scorer) if never called.
+//
+// Parameters:
+//   - p: The SentimentProvider implementation (e.g. finalytics.HTTPSentimentProvider{URL: "..."}).
+//
+// Returns:
+//   - *TickerBuilder: The builder instance for method chaining.
+//
+// Example:
+//   builder := finalytics.NewTickerBuilder().SentimentProvider(finalytics.HTTPSentimentProvider{URL: "https://example.com/sentiment"})
+func (b *TickerBuilder) SentimentProvider(p SentimentProvider) *TickerBuilder {
+    b.sentimentProvider = p
+    return b
+}
+
+// StreamProvider sets the live-quote provider used by the built Ticker's
+// SubscribeQuotes.
+//
+// Parameters:
+//   - name: "yahoo" (the default) for Yahoo Finance's streaming endpoint,
+//     "mock" for MockQuoteStream (for tests), or a "ws://"/"wss://" URL for a
+//     user-supplied websocket endpoint that speaks the same quote frame shape.
+//
+// Returns:
+//   - *TickerBuilder: The builder instance for method chaining.
+//
+// Example:
+//   builder := finalytics.NewTickerBuilder().StreamProvider("mock")
+func (b *TickerBuilder) StreamProvider(name string) *TickerBuilder {
+    b.streamProviderName = name
+    return b
+}
+
 // Symbol sets the ticker symbol for the Ticker.
 //
 // Parameters:
@@ -207,6 +333,29 @@ func (b *TickerBuilder) BenchmarkData(benchmarkData *dataframe.DataFrame) *Ticke
     return b
 }
 
+// Cache enables an on-disk cache for the Ticker's OHLCV and fundamentals
+// responses, persisted as JSON snapshots under dir. ttl is the default time a
+// cached response stays valid; per-endpoint TTLs (e.g. ~24h for fundamentals,
+// ~5m for the options chain) are applied automatically where they differ
+// from ttl. A price history entry is keyed by the Ticker's full date range
+// and is fetched again whole once its TTL expires; unlike
+// TickersBuilder.Cache, a later end date does not extend an existing entry.
+//
+// Parameters:
+//   - dir: The directory to persist cached responses under (created if missing).
+//   - ttl: The default cache entry lifetime.
+//
+// Returns:
+//   - *TickerBuilder: The builder instance for method chaining.
+//
+// Example:
+//   builder := finalytics.NewTickerBuilder().Cache("/tmp/finalytics-cache", 10*time.Minute)
+func (b *TickerBuilder) Cache(dir string, ttl time.Duration) *TickerBuilder {
+    b.cacheDir = dir
+    b.cacheTTL = ttl
+    return b
+}
+
 // Build constructs the Ticker instance with the configured parameters.
 // The symbol parameter is required; other parameters are optional and use defaults if not set.
 //
@@ -242,6 +391,9 @@ func (b *TickerBuilder) Build() (*Ticker, error) {
     if b.symbol == "" {
         return nil, errors.New("symbol is required")
     }
+    if err := validateSymbolsForAssetClass(b.assetClass, []string{b.symbol}); err != nil {
+        return nil, err
+    }
 
     // Use empty strings for optional parameters if not set
     cSymbol := C.CString(b.symbol)
@@ -296,7 +448,37 @@ func (b *TickerBuilder) Build() (*Ticker, error) {
     if handle == nil {
         return nil, errors.New("failed to create Ticker")
     }
-    return &Ticker{handle: handle}, nil
+    streamProvider, err := resolveQuoteStream(b.streamProviderName)
+    if err != nil {
+        return nil, err
+    }
+
+    var cache *diskCache
+    if b.cacheDir != "" {
+        cache = newDiskCache(b.cacheDir, b.cacheTTL)
+        registerCache(cache)
+    }
+
+    return &Ticker{
+        handle:            handle,
+        interval:          b.interval,
+        symbol:            b.symbol,
+        assetClass:        b.assetClass,
+        startDate:         b.startDate,
+        endDate:           b.endDate,
+        confidenceLevel:   b.confidenceLevel,
+        riskFreeRate:      b.riskFreeRate,
+        dividendYield:     b.dividendYield,
+        streamProvider:    streamProvider,
+        sentimentProvider: b.sentimentProvider,
+        provider:          b.provider,
+        cache:             cache,
+    }, nil
+}
+
+// AssetClass returns the asset class the Ticker was built with.
+func (t *Ticker) AssetClass() AssetClass {
+    return t.assetClass
 }
 
 // Free releases the resources associated with the Ticker.
@@ -364,6 +546,9 @@ func (t *Ticker) Free() {
 //   	fmt.Printf("Quote: %v\n", quote)
 //   }
 func (t *Ticker) GetQuote() (map[string]any, error) {
+    if t.provider != nil {
+        return t.provider.Quote(context.Background(), t.symbol)
+    }
     var cOutput *C.char
     result := C.finalytics_ticker_get_quote(t.handle, &cOutput)
     if result != 0 {
@@ -449,6 +634,18 @@ func (t *Ticker) GetSummaryStats() (dataframe.DataFrame, error) {
 //   	fmt.Printf("Price History:\n%v\n", history)
 //   }
 func (t *Ticker) GetPriceHistory() (dataframe.DataFrame, error) {
+    if t.cache != nil {
+        return t.getPriceHistoryCached()
+    }
+    return t.fetchPriceHistory()
+}
+
+// fetchPriceHistory issues the uncached call for the OHLCV price history,
+// through t.provider if one is configured, otherwise the FFI.
+func (t *Ticker) fetchPriceHistory() (dataframe.DataFrame, error) {
+    if t.provider != nil {
+        return t.provider.Candles(context.Background(), t.symbol, t.startDate, t.endDate, t.interval)
+    }
     var cOutput *C.char
     result := C.finalytics_ticker_get_price_history(t.handle, &cOutput)
     if result != 0 {
@@ -457,6 +654,26 @@ func (t *Ticker) GetPriceHistory() (dataframe.DataFrame, error) {
     return parseJSONToDataFrame(cOutput)
 }
 
+// GetPriceHistoryArrow retrieves the OHLCV price history for the ticker the same
+// way as GetPriceHistory, but over the native Arrow IPC FFI path instead of a
+// JSON round-trip, which is significantly faster for large history windows.
+//
+// Returns:
+//   - dataframe.DataFrame: A DataFrame containing the price history data.
+//   - error: An error if the price history retrieval fails.
+//
+// Example:
+//   history, err := ticker.GetPriceHistoryArrow()
+func (t *Ticker) GetPriceHistoryArrow() (dataframe.DataFrame, error) {
+    var cOutput *C.char
+    var cLen C.long
+    result := C.finalytics_ticker_get_price_history_arrow(t.handle, &cOutput, &cLen)
+    if result != 0 {
+        return dataframe.DataFrame{}, fmt.Errorf("failed to get price history: error code %d", result)
+    }
+    return parseArrowBytesResult(cOutput, cLen)
+}
+
 // GetOptionsChain retrieves the options chain for the ticker.
 //
 // Returns:
@@ -490,12 +707,21 @@ func (t *Ticker) GetPriceHistory() (dataframe.DataFrame, error) {
 //   	fmt.Printf("Options Chain:\n%v\n", options)
 //   }
 func (t *Ticker) GetOptionsChain() (dataframe.DataFrame, error) {
-    var cOutput *C.char
-    result := C.finalytics_ticker_get_options_chain(t.handle, &cOutput)
-    if result != 0 {
-        return dataframe.DataFrame{}, fmt.Errorf("failed to get options chain: error code %d", result)
+    if t.provider != nil {
+        return t.provider.OptionsChain(context.Background(), t.symbol)
     }
-    return parseJSONToDataFrame(cOutput)
+    fetch := func() (dataframe.DataFrame, error) {
+        var cOutput *C.char
+        result := C.finalytics_ticker_get_options_chain(t.handle, &cOutput)
+        if result != 0 {
+            return dataframe.DataFrame{}, fmt.Errorf("failed to get options chain: error code %d", result)
+        }
+        return parseJSONToDataFrame(cOutput)
+    }
+    if t.cache == nil {
+        return fetch()
+    }
+    return t.cachedDataFrame("options_chain", fetch)
 }
 
 // GetNews retrieves the latest news headlines for the ticker.
@@ -533,6 +759,9 @@ func (t *Ticker) GetOptionsChain() (dataframe.DataFrame, error) {
 //   	fmt.Printf("News:\n%v\n", news)
 //   }
 func (t *Ticker) GetNews() (dataframe.DataFrame, error) {
+    if t.provider != nil {
+        return t.provider.News(context.Background(), t.symbol)
+    }
     var cOutput *C.char
     result := C.finalytics_ticker_get_news(t.handle, &cOutput)
     if result != 0 {
@@ -578,18 +807,27 @@ func (t *Ticker) GetNews() (dataframe.DataFrame, error) {
 //   	fmt.Printf("Income Statement:\n%v\n", income)
 //   }
 func (t *Ticker) GetIncomeStatement(frequency string, formatted bool) (dataframe.DataFrame, error) {
-    cFrequency := C.CString(frequency)
-    defer C.free(unsafe.Pointer(cFrequency))
-    cFormatted := C.int(0)
-    if formatted {
-        cFormatted = C.int(1)
+    if t.provider != nil {
+        return t.provider.Fundamentals(context.Background(), t.symbol, "income", frequency, formatted)
     }
-    var cOutput *C.char
-    result := C.finalytics_ticker_get_income_statement(t.handle, cFrequency, cFormatted, &cOutput)
-    if result != 0 {
-        return dataframe.DataFrame{}, fmt.Errorf("failed to get income statement: error code %d", result)
+    fetch := func() (dataframe.DataFrame, error) {
+        cFrequency := C.CString(frequency)
+        defer C.free(unsafe.Pointer(cFrequency))
+        cFormatted := C.int(0)
+        if formatted {
+            cFormatted = C.int(1)
+        }
+        var cOutput *C.char
+        result := C.finalytics_ticker_get_income_statement(t.handle, cFrequency, cFormatted, &cOutput)
+        if result != 0 {
+            return dataframe.DataFrame{}, fmt.Errorf("failed to get income statement: error code %d", result)
+        }
+        return parseJSONToDataFrame(cOutput)
     }
-    return parseJSONToDataFrame(cOutput)
+    if t.cache == nil {
+        return fetch()
+    }
+    return t.cachedDataFrameVariant("income_statement", fundamentalsVariant(frequency, formatted), fetch)
 }
 
 // GetBalanceSheet retrieves the balance sheet for the ticker.
@@ -629,18 +867,27 @@ func (t *Ticker) GetIncomeStatement(frequency string, formatted bool) (dataframe
 //   	fmt.Printf("Balance Sheet:\n%v\n", balance)
 //   }
 func (t *Ticker) GetBalanceSheet(frequency string, formatted bool) (dataframe.DataFrame, error) {
-    cFrequency := C.CString(frequency)
-    defer C.free(unsafe.Pointer(cFrequency))
-    cFormatted := C.int(0)
-    if formatted {
-        cFormatted = C.int(1)
+    if t.provider != nil {
+        return t.provider.Fundamentals(context.Background(), t.symbol, "balance", frequency, formatted)
     }
-    var cOutput *C.char
-    result := C.finalytics_ticker_get_balance_sheet(t.handle, cFrequency, cFormatted, &cOutput)
-    if result != 0 {
-        return dataframe.DataFrame{}, fmt.Errorf("failed to get balance sheet: error code %d", result)
+    fetch := func() (dataframe.DataFrame, error) {
+        cFrequency := C.CString(frequency)
+        defer C.free(unsafe.Pointer(cFrequency))
+        cFormatted := C.int(0)
+        if formatted {
+            cFormatted = C.int(1)
+        }
+        var cOutput *C.char
+        result := C.finalytics_ticker_get_balance_sheet(t.handle, cFrequency, cFormatted, &cOutput)
+        if result != 0 {
+            return dataframe.DataFrame{}, fmt.Errorf("failed to get balance sheet: error code %d", result)
+        }
+        return parseJSONToDataFrame(cOutput)
     }
-    return parseJSONToDataFrame(cOutput)
+    if t.cache == nil {
+        return fetch()
+    }
+    return t.cachedDataFrameVariant("balance_sheet", fundamentalsVariant(frequency, formatted), fetch)
 }
 
 // GetCashflowStatement retrieves the cash flow statement for the ticker.
@@ -680,18 +927,27 @@ func (t *Ticker) GetBalanceSheet(frequency string, formatted bool) (dataframe.Da
 //   	fmt.Printf("Cash Flow Statement:\n%v\n", cashflow)
 //   }
 func (t *Ticker) GetCashflowStatement(frequency string, formatted bool) (dataframe.DataFrame, error) {
-    cFrequency := C.CString(frequency)
-    defer C.free(unsafe.Pointer(cFrequency))
-    cFormatted := C.int(0)
-    if formatted {
-        cFormatted = C.int(1)
+    if t.provider != nil {
+        return t.provider.Fundamentals(context.Background(), t.symbol, "cashflow", frequency, formatted)
     }
-    var cOutput *C.char
-    result := C.finalytics_ticker_get_cashflow_statement(t.handle, cFrequency, cFormatted, &cOutput)
-    if result != 0 {
-        return dataframe.DataFrame{}, fmt.Errorf("failed to get cash flow statement: error code %d", result)
+    fetch := func() (dataframe.DataFrame, error) {
+        cFrequency := C.CString(frequency)
+        defer C.free(unsafe.Pointer(cFrequency))
+        cFormatted := C.int(0)
+        if formatted {
+            cFormatted = C.int(1)
+        }
+        var cOutput *C.char
+        result := C.finalytics_ticker_get_cashflow_statement(t.handle, cFrequency, cFormatted, &cOutput)
+        if result != 0 {
+            return dataframe.DataFrame{}, fmt.Errorf("failed to get cash flow statement: error code %d", result)
+        }
+        return parseJSONToDataFrame(cOutput)
     }
-    return parseJSONToDataFrame(cOutput)
+    if t.cache == nil {
+        return fetch()
+    }
+    return t.cachedDataFrameVariant("cashflow_statement", fundamentalsVariant(frequency, formatted), fetch)
 }
 
 // GetFinancialRatios retrieves financial ratios for the ticker.
@@ -824,7 +1080,43 @@ func (t *Ticker) PerformanceStats() (map[string]any, error) {
     if result != 0 {
         return nil, fmt.Errorf("failed to get performance stats: error code %d", result)
     }
-    return parseJSONResult(cOutput)
+    stats, err := parseJSONResult(cOutput)
+    if err != nil {
+        return nil, err
+    }
+    t.addDrawdownRatios(stats)
+    return stats, nil
+}
+
+// addDrawdownRatios computes the MAR ratio, Ulcer Index, and a RiskMetrics
+// snapshot from the ticker's close price history, merging them into stats
+// under "marRatio", "ulcerIndex", "calmarRatio", "omegaRatio",
+// "sortinoRatio", "profitFactor", "winRate", "maxDrawdown",
+// "maxDrawdownDuration", and "var". It is best-effort: if the price history
+// cannot be retrieved, stats is left unchanged rather than failing
+// PerformanceStats outright.
+func (t *Ticker) addDrawdownRatios(stats map[string]any) {
+    history, err := t.GetPriceHistory()
+    if err != nil {
+        return
+    }
+    closes := history.Col("close").Float()
+    if len(closes) < 2 {
+        return
+    }
+    periodsPerYear := annualPeriods(t.interval)
+    metrics := computeRiskMetrics(closes, t.interval, t.riskFreeRate, t.confidenceLevel)
+
+    stats["marRatio"] = marRatio(closes, periodsPerYear)
+    stats["ulcerIndex"] = ulcerIndex(closes)
+    stats["calmarRatio"] = metrics.Calmar
+    stats["omegaRatio"] = metrics.Omega
+    stats["sortinoRatio"] = metrics.Sortino
+    stats["profitFactor"] = metrics.ProfitFactor
+    stats["winRate"] = metrics.WinRate
+    stats["maxDrawdown"] = metrics.MaxDrawdown
+    stats["maxDrawdownDuration"] = metrics.MaxDrawdownDuration
+    stats["var"] = metrics.VaR
 }
 
 // PerformanceChart retrieves the performance chart for the ticker as an HTML object.
@@ -868,7 +1160,7 @@ func (t *Ticker) PerformanceStats() (map[string]any, error) {
 //   	}
 //   	perfChart.Show()
 //   }
-func (t *Ticker) PerformanceChart(height, width uint) (HTML, error) {
+func (t *Ticker) PerformanceChart(height, width uint, opts ...ChartOptions) (HTML, error) {
     var cOutput *C.char
     result := C.finalytics_ticker_performance_chart(t.handle, C.uint(height), C.uint(width), &cOutput)
     if result != 0 {
@@ -876,7 +1168,8 @@ func (t *Ticker) PerformanceChart(height, width uint) (HTML, error) {
     }
     defer C.finalytics_free_string(cOutput)
     htmlStr := C.GoString(cOutput)
-    return HTML{Content: htmlStr}, nil
+    htmlStr = applyChartOptions(htmlStr, opts...)
+    return HTML{Content: t.drawIndicatorOverlays(htmlStr, opts...)}, nil
 }
 
 // CandlestickChart retrieves the candlestick chart for the ticker as an HTML object.
@@ -917,7 +1210,7 @@ func (t *Ticker) PerformanceChart(height, width uint) (HTML, error) {
 //   	}
 //   	candleChart.Show()
 //   }
-func (t *Ticker) CandlestickChart(height, width uint) (HTML, error) {
+func (t *Ticker) CandlestickChart(height, width uint, opts ...ChartOptions) (HTML, error) {
     var cOutput *C.char
     result := C.finalytics_ticker_candlestick_chart(t.handle, C.uint(height), C.uint(width), &cOutput)
     if result != 0 {
@@ -925,7 +1218,8 @@ func (t *Ticker) CandlestickChart(height, width uint) (HTML, error) {
     }
     defer C.finalytics_free_string(cOutput)
     htmlStr := C.GoString(cOutput)
-    return HTML{Content: htmlStr}, nil
+    htmlStr = applyChartOptions(htmlStr, opts...)
+    return HTML{Content: t.drawIndicatorOverlays(htmlStr, opts...)}, nil
 }
 
 // OptionsChart retrieves the options chart (e.g., volatility surface, smile, or term structure) for the ticker as an HTML object.
@@ -964,7 +1258,7 @@ func (t *Ticker) CandlestickChart(height, width uint) (HTML, error) {
 //   	}
 //   	optChart.Show()
 //   }
-func (t *Ticker) OptionsChart(chartType string, height, width uint) (HTML, error) {
+func (t *Ticker) OptionsChart(chartType string, height, width uint, opts ...ChartOptions) (HTML, error) {
     cChartType := C.CString(chartType)
     defer C.free(unsafe.Pointer(cChartType))
     var cOutput *C.char
@@ -974,7 +1268,7 @@ func (t *Ticker) OptionsChart(chartType string, height, width uint) (HTML, error
     }
     defer C.finalytics_free_string(cOutput)
     htmlStr := C.GoString(cOutput)
-    return HTML{Content: htmlStr}, nil
+    return HTML{Content: applyChartOptions(htmlStr, opts...)}, nil
 }
 
 // NewsSentimentChart retrieves the news sentiment chart for the ticker as an HTML object.
@@ -1014,7 +1308,7 @@ func (t *Ticker) OptionsChart(chartType string, height, width uint) (HTML, error
 //   	}
 //   	newsChart.Show()
 //   }
-func (t *Ticker) NewsSentimentChart(height, width uint) (HTML, error) {
+func (t *Ticker) NewsSentimentChart(height, width uint, opts ...ChartOptions) (HTML, error) {
     var cOutput *C.char
     result := C.finalytics_ticker_news_sentiment_chart(t.handle, C.uint(height), C.uint(width), &cOutput)
     if result != 0 {
@@ -1022,7 +1316,7 @@ func (t *Ticker) NewsSentimentChart(height, width uint) (HTML, error) {
     }
     defer C.finalytics_free_string(cOutput)
     htmlStr := C.GoString(cOutput)
-    return HTML{Content: htmlStr}, nil
+    return HTML{Content: applyChartOptions(htmlStr, opts...)}, nil
 }
 
 // Report retrieves a comprehensive analytics report for the ticker as an HTML object.
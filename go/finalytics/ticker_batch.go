@@ -0,0 +1,256 @@
+package finalytics
+
+import (
+    "errors"
+    "fmt"
+    "sync"
+    "time"
+
+    "github.com/go-gota/gota/dataframe"
+)
+
+// defaultBatchWorkers is the worker pool size used by NewTickerBatch and
+// TickerBatch's aggregate methods when BatchOptions.MaxWorkers is unset.
+const defaultBatchWorkers = 4
+
+// BatchOptions configures NewTickerBatch. It mirrors the TickerBuilder
+// options shared across every symbol in the batch.
+type BatchOptions struct {
+    StartDate       string
+    EndDate         string
+    Interval        string
+    BenchmarkSymbol string
+    ConfidenceLevel float64
+    RiskFreeRate    float64
+    CacheDir        string
+    CacheTTL        time.Duration
+    // MaxWorkers bounds how many symbols are built or queried concurrently.
+    // Defaults to defaultBatchWorkers if <= 0.
+    MaxWorkers int
+}
+
+// TickerBatch holds a Ticker per successfully built symbol, constructed
+// concurrently by NewTickerBatch. Symbols that failed to build are recorded
+// in Errors rather than causing the whole batch to fail, so a handful of bad
+// symbols in a large screener universe don't block analytics on the rest.
+type TickerBatch struct {
+    symbols []string
+    tickers map[string]*Ticker
+    errs    map[string]error
+    // maxWorkers is BatchOptions.MaxWorkers, reused by the aggregate methods.
+    maxWorkers int
+}
+
+// NewTickerBatch builds a Ticker for every symbol concurrently, using a
+// worker pool bounded by opts.MaxWorkers, and shares a single fetch of
+// opts.BenchmarkSymbol's price history across all of them instead of
+// re-fetching it once per symbol. Callers that previously looped
+// TickerBuilder.Build() per symbol and tracked Free() themselves can use
+// this for bounded-parallelism, portfolio-wide construction instead.
+//
+// Per-symbol build failures do not fail the call: a symbol that could not be
+// built is recorded in TickerBatch.Errors and omitted from the batch. An
+// error is only returned, as an errors.Join of every per-symbol failure, if
+// every symbol failed to build.
+//
+// Parameters:
+//   - symbols: The ticker symbols to build (e.g., []string{"AAPL", "MSFT"}).
+//   - opts: Shared build options applied to every symbol.
+//
+// Returns:
+//   - *TickerBatch: The built batch, or nil if every symbol failed.
+//   - error: An errors.Join of every per-symbol build error, if every symbol failed.
+//
+// Example:
+//   batch, err := finalytics.NewTickerBatch([]string{"AAPL", "MSFT", "GOOG"}, finalytics.BatchOptions{
+//   	StartDate:       "2023-01-01",
+//   	EndDate:         "2023-12-31",
+//   	BenchmarkSymbol: "^GSPC",
+//   })
+//   if err != nil {
+//   	fmt.Printf("Failed to build batch: %v\n", err)
+//   	return
+//   }
+//   defer batch.Free()
+func NewTickerBatch(symbols []string, opts BatchOptions) (*TickerBatch, error) {
+    if len(symbols) == 0 {
+        return nil, errors.New("symbols is required")
+    }
+
+    var benchmarkData *dataframe.DataFrame
+    if opts.BenchmarkSymbol != "" {
+        benchTicker, err := NewTickerBuilder().
+            Symbol(opts.BenchmarkSymbol).
+            StartDate(opts.StartDate).
+            EndDate(opts.EndDate).
+            Interval(opts.Interval).
+            Build()
+        if err == nil {
+            history, err := benchTicker.GetPriceHistory()
+            benchTicker.Free()
+            if err == nil {
+                benchmarkData = &history
+            }
+        }
+    }
+
+    type built struct {
+        symbol string
+        ticker *Ticker
+        err    error
+    }
+
+    results := runBatchPool(symbols, opts.MaxWorkers, func(symbol string) built {
+        builder := NewTickerBuilder().
+            Symbol(symbol).
+            StartDate(opts.StartDate).
+            EndDate(opts.EndDate).
+            Interval(opts.Interval).
+            BenchmarkSymbol(opts.BenchmarkSymbol).
+            ConfidenceLevel(opts.ConfidenceLevel).
+            RiskFreeRate(opts.RiskFreeRate)
+        if benchmarkData != nil {
+            builder = builder.BenchmarkData(benchmarkData)
+        }
+        if opts.CacheDir != "" {
+            builder = builder.Cache(opts.CacheDir, opts.CacheTTL)
+        }
+        ticker, err := builder.Build()
+        return built{symbol: symbol, ticker: ticker, err: err}
+    })
+
+    tickers := make(map[string]*Ticker, len(symbols))
+    errs := make(map[string]error)
+    var buildErrs []error
+    for _, r := range results {
+        if r.err != nil {
+            errs[r.symbol] = r.err
+            buildErrs = append(buildErrs, fmt.Errorf("%s: %w", r.symbol, r.err))
+            continue
+        }
+        tickers[r.symbol] = r.ticker
+    }
+
+    if len(tickers) == 0 {
+        return nil, errors.Join(buildErrs...)
+    }
+
+    return &TickerBatch{symbols: symbols, tickers: tickers, errs: errs, maxWorkers: opts.MaxWorkers}, nil
+}
+
+// Errors returns the build errors for symbols that failed in NewTickerBatch,
+// keyed by symbol. It is empty if every symbol built successfully.
+func (b *TickerBatch) Errors() map[string]error {
+    return b.errs
+}
+
+// Free releases every Ticker in the batch. It should be deferred once after
+// NewTickerBatch succeeds, mirroring Ticker.Free.
+func (b *TickerBatch) Free() {
+    for _, t := range b.tickers {
+        t.Free()
+    }
+}
+
+// builtSymbols returns the symbols that were built successfully, in the
+// order passed to NewTickerBatch.
+func (b *TickerBatch) builtSymbols() []string {
+    symbols := make([]string, 0, len(b.tickers))
+    for _, symbol := range b.symbols {
+        if _, ok := b.tickers[symbol]; ok {
+            symbols = append(symbols, symbol)
+        }
+    }
+    return symbols
+}
+
+// GetPriceHistories retrieves the OHLCV price history for every ticker in
+// the batch concurrently.
+//
+// Returns:
+//   - map[string]dataframe.DataFrame: Price history keyed by symbol.
+//   - error: An errors.Join of every per-symbol fetch error, if any failed.
+func (b *TickerBatch) GetPriceHistories() (map[string]dataframe.DataFrame, error) {
+    type fetched struct {
+        symbol string
+        df     dataframe.DataFrame
+        err    error
+    }
+    results := runBatchPool(b.builtSymbols(), b.maxWorkers, func(symbol string) fetched {
+        df, err := b.tickers[symbol].GetPriceHistory()
+        return fetched{symbol: symbol, df: df, err: err}
+    })
+
+    histories := make(map[string]dataframe.DataFrame, len(results))
+    var fetchErrs []error
+    for _, r := range results {
+        if r.err != nil {
+            fetchErrs = append(fetchErrs, fmt.Errorf("%s: %w", r.symbol, r.err))
+            continue
+        }
+        histories[r.symbol] = r.df
+    }
+    return histories, errors.Join(fetchErrs...)
+}
+
+// PerformanceStats retrieves performance statistics for every ticker in the
+// batch concurrently.
+//
+// Returns:
+//   - map[string]map[string]any: Performance stats keyed by symbol.
+//   - error: An errors.Join of every per-symbol fetch error, if any failed.
+func (b *TickerBatch) PerformanceStats() (map[string]map[string]any, error) {
+    type fetched struct {
+        symbol string
+        stats  map[string]any
+        err    error
+    }
+    results := runBatchPool(b.builtSymbols(), b.maxWorkers, func(symbol string) fetched {
+        stats, err := b.tickers[symbol].PerformanceStats()
+        return fetched{symbol: symbol, stats: stats, err: err}
+    })
+
+    stats := make(map[string]map[string]any, len(results))
+    var fetchErrs []error
+    for _, r := range results {
+        if r.err != nil {
+            fetchErrs = append(fetchErrs, fmt.Errorf("%s: %w", r.symbol, r.err))
+            continue
+        }
+        stats[r.symbol] = r.stats
+    }
+    return stats, errors.Join(fetchErrs...)
+}
+
+// runBatchPool runs fn once per item, using a worker pool bounded by
+// maxWorkers (defaultBatchWorkers if <= 0), and returns the results in the
+// same order as items.
+func runBatchPool[I any, R any](items []I, maxWorkers int, fn func(I) R) []R {
+    workers := maxWorkers
+    if workers <= 0 {
+        workers = defaultBatchWorkers
+    }
+    if workers > len(items) {
+        workers = len(items)
+    }
+
+    results := make([]R, len(items))
+    jobs := make(chan int)
+    var wg sync.WaitGroup
+    for i := 0; i < workers; i++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for idx := range jobs {
+                results[idx] = fn(items[idx])
+            }
+        }()
+    }
+    for idx := range items {
+        jobs <- idx
+    }
+    close(jobs)
+    wg.Wait()
+
+    return results
+}
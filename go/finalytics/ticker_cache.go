@@ -0,0 +1,73 @@
+package finalytics
+
+import (
+    "fmt"
+
+    "github.com/go-gota/gota/dataframe"
+)
+
+// cachedDataFrame serves endpoint from t.cache if a fresh entry exists,
+// otherwise calls fetch and stores the result before returning it.
+func (t *Ticker) cachedDataFrame(endpoint string, fetch func() (dataframe.DataFrame, error)) (dataframe.DataFrame, error) {
+    return t.cachedDataFrameVariant(endpoint, "", fetch)
+}
+
+// cachedDataFrameVariant is cachedDataFrame with an extra discriminator
+// folded into the cache key, for endpoints whose response also depends on
+// call arguments (e.g. statement frequency/formatting).
+func (t *Ticker) cachedDataFrameVariant(endpoint, variant string, fetch func() (dataframe.DataFrame, error)) (dataframe.DataFrame, error) {
+    key := cacheKey("yahoo", t.symbol, endpoint, t.interval+"|"+variant, t.startDate, t.endDate)
+    if cached, ok := t.cache.get(endpoint, key); ok {
+        return cached, nil
+    }
+    df, err := fetch()
+    if err != nil {
+        return df, err
+    }
+    if err := t.cache.put(endpoint, key, df); err != nil {
+        return df, fmt.Errorf("fetched data but failed to cache it: %v", err)
+    }
+    return df, nil
+}
+
+// getPriceHistoryCached serves GetPriceHistory through t.cache. Cache
+// entries are keyed by symbol, interval and date range: since a built
+// Ticker's date range is fixed for its lifetime, the entry is simply
+// refreshed once its TTL expires rather than incrementally extended the way
+// Tickers.getPriceHistoryCached widens endDate across calls.
+func (t *Ticker) getPriceHistoryCached() (dataframe.DataFrame, error) {
+    const endpoint = "price_history"
+    key := cacheKey("yahoo", t.symbol, endpoint, t.interval, t.startDate, t.endDate)
+
+    if cached, ok := t.cache.get(endpoint, key); ok {
+        return cached, nil
+    }
+    df, err := t.fetchPriceHistory()
+    if err != nil {
+        return df, err
+    }
+    if err := t.cache.put(endpoint, key, df); err != nil {
+        return df, fmt.Errorf("fetched price history but failed to cache it: %v", err)
+    }
+    return df, nil
+}
+
+// InvalidateCache removes every cached entry for endpoint (e.g.
+// "price_history", "options_chain", "income_statement"), forcing the next
+// matching call to re-fetch from its source (the Ticker's Provider, if one is
+// set, otherwise the underlying FFI). It is a no-op if the Ticker has no cache configured via TickerBuilder.Cache.
+//
+// Parameters:
+//   - endpoint: The cached endpoint to invalidate.
+//
+// Returns:
+//   - error: An error if the cache entries could not be removed.
+//
+// Example:
+//   err := ticker.InvalidateCache("options_chain")
+func (t *Ticker) InvalidateCache(endpoint string) error {
+    if t.cache == nil {
+        return nil
+    }
+    return t.cache.invalidate(endpoint)
+}
@@ -0,0 +1,106 @@
+package finalytics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// Candles retrieves the ticker's OHLCV price history as typed Candle
+// records, restricted to the half-open window [from, to). resolution must
+// match the interval the Ticker was built with (see TickerBuilder.Interval);
+// a Ticker's bar size is fixed at Build time, so this exists to catch a
+// caller asking for a resolution the Ticker wasn't configured for rather
+// than silently resampling.
+//
+// Volume is always 0 for IndexAssetClass (indices have no traded volume) and
+// for MutualFundAssetClass (Close instead holds the fund's daily NAV per
+// share, mirroring Tickers.FundCandles), regardless of what the underlying
+// endpoint reports.
+//
+// Parameters:
+//   - resolution: The bar size to request (e.g. "1d"); must equal the
+//     Ticker's configured interval.
+//   - from: The inclusive start of the window.
+//   - to: The exclusive end of the window.
+//
+// Returns:
+//   - []Candle: OHLCV records within [from, to), ordered as returned by the price history.
+//   - error: An error if resolution doesn't match the Ticker's interval, or the underlying fetch fails.
+//
+// Example:
+//   candles, err := ticker.Candles("1d", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Now())
+func (t *Ticker) Candles(resolution string, from, to time.Time) ([]Candle, error) {
+	if resolution != "" && resolution != t.interval {
+		return nil, fmt.Errorf("ticker was built with interval %q, not %q; rebuild with TickerBuilder.Interval(%q) to request that resolution", t.interval, resolution, resolution)
+	}
+
+	df, err := t.GetPriceHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	dates := df.Col("timestamp").Records()
+	opens := df.Col("open").Float()
+	highs := df.Col("high").Float()
+	lows := df.Col("low").Float()
+	closes := df.Col("close").Float()
+	volumes := df.Col("volume").Float()
+
+	hasVolume := t.assetClass != IndexAssetClass && t.assetClass != MutualFundAssetClass
+
+	candles := make([]Candle, 0, len(dates))
+	for i, date := range dates {
+		ts, err := parseCandleTimestamp(date)
+		if err != nil || ts.Before(from) || !ts.Before(to) {
+			continue
+		}
+		candle := Candle{
+			Date:  date,
+			Open:  floatAt(opens, i),
+			High:  floatAt(highs, i),
+			Low:   floatAt(lows, i),
+			Close: floatAt(closes, i),
+		}
+		if hasVolume {
+			candle.Volume = floatAt(volumes, i)
+		}
+		candles = append(candles, candle)
+	}
+	return candles, nil
+}
+
+// CandlesDataFrame retrieves the ticker's OHLCV price history as a
+// DataFrame, for callers feeding analytics pipelines that want the whole
+// frame rather than scraping a chart's HTML. It is GetPriceHistory with
+// Volume zeroed out for asset classes that don't report it, matching
+// Candles.
+//
+// Returns:
+//   - dataframe.DataFrame: The ticker's OHLCV price history.
+//   - error: An error if the underlying fetch fails.
+//
+// Example:
+//   df, err := ticker.CandlesDataFrame()
+func (t *Ticker) CandlesDataFrame() (dataframe.DataFrame, error) {
+	df, err := t.GetPriceHistory()
+	if err != nil {
+		return dataframe.DataFrame{}, err
+	}
+	if t.assetClass != IndexAssetClass && t.assetClass != MutualFundAssetClass {
+		return df, nil
+	}
+	return df.Mutate(series.New(make([]float64, df.Nrow()), series.Float, "volume")), nil
+}
+
+// parseCandleTimestamp parses a price history row's timestamp column, which
+// is formatted either as RFC3339 (intraday intervals) or "2006-01-02"
+// (daily and coarser intervals).
+func parseCandleTimestamp(value string) (time.Time, error) {
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return ts, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
@@ -0,0 +1,140 @@
+package finalytics
+
+/*
+#include <finalytics.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"context"
+	"unsafe"
+
+	"github.com/go-gota/gota/dataframe"
+)
+
+// GetQuoteContext is GetQuote, but the underlying FFI call runs on a
+// goroutine and is abandoned early if ctx is done before it returns. On
+// failure it returns one of ErrRateLimited, ErrSymbolNotFound or ErrNetwork
+// instead of an opaque error code where the underlying cause is known.
+func (t *Ticker) GetQuoteContext(ctx context.Context) (map[string]any, error) {
+	return runMapFFI(ctx, "get quote", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_ticker_get_quote(t.handle, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetSummaryStatsContext is GetSummaryStats, but the underlying FFI call runs
+// on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Ticker) GetSummaryStatsContext(ctx context.Context) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get summary stats", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_ticker_get_summary_stats(t.handle, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetPriceHistoryContext is GetPriceHistory, but the underlying FFI call runs
+// on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Ticker) GetPriceHistoryContext(ctx context.Context) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get price history", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_ticker_get_price_history(t.handle, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetOptionsChainContext is GetOptionsChain, but the underlying FFI call runs
+// on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Ticker) GetOptionsChainContext(ctx context.Context) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get options chain", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_ticker_get_options_chain(t.handle, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetNewsContext is GetNews, but the underlying FFI call runs on a goroutine
+// and is abandoned early if ctx is done before it returns.
+func (t *Ticker) GetNewsContext(ctx context.Context) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get news", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_ticker_get_news(t.handle, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetIncomeStatementContext is GetIncomeStatement, but the underlying FFI
+// call runs on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Ticker) GetIncomeStatementContext(ctx context.Context, frequency string, formatted bool) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get income statement", func() (*C.char, C.int) {
+		cFrequency := C.CString(frequency)
+		defer C.free(unsafe.Pointer(cFrequency))
+		cFormatted := C.int(0)
+		if formatted {
+			cFormatted = C.int(1)
+		}
+		var cOutput *C.char
+		code := C.finalytics_ticker_get_income_statement(t.handle, cFrequency, cFormatted, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetBalanceSheetContext is GetBalanceSheet, but the underlying FFI call runs
+// on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Ticker) GetBalanceSheetContext(ctx context.Context, frequency string, formatted bool) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get balance sheet", func() (*C.char, C.int) {
+		cFrequency := C.CString(frequency)
+		defer C.free(unsafe.Pointer(cFrequency))
+		cFormatted := C.int(0)
+		if formatted {
+			cFormatted = C.int(1)
+		}
+		var cOutput *C.char
+		code := C.finalytics_ticker_get_balance_sheet(t.handle, cFrequency, cFormatted, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetCashflowStatementContext is GetCashflowStatement, but the underlying FFI
+// call runs on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Ticker) GetCashflowStatementContext(ctx context.Context, frequency string, formatted bool) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get cash flow statement", func() (*C.char, C.int) {
+		cFrequency := C.CString(frequency)
+		defer C.free(unsafe.Pointer(cFrequency))
+		cFormatted := C.int(0)
+		if formatted {
+			cFormatted = C.int(1)
+		}
+		var cOutput *C.char
+		code := C.finalytics_ticker_get_cashflow_statement(t.handle, cFrequency, cFormatted, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetFinancialRatiosContext is GetFinancialRatios, but the underlying FFI
+// call runs on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Ticker) GetFinancialRatiosContext(ctx context.Context, frequency string) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get financial ratios", func() (*C.char, C.int) {
+		cFrequency := C.CString(frequency)
+		defer C.free(unsafe.Pointer(cFrequency))
+		var cOutput *C.char
+		code := C.finalytics_ticker_get_financial_ratios(t.handle, cFrequency, &cOutput)
+		return cOutput, code
+	})
+}
+
+// PerformanceStatsContext is PerformanceStats, but the underlying FFI call
+// runs on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Ticker) PerformanceStatsContext(ctx context.Context) (map[string]any, error) {
+	stats, err := runMapFFI(ctx, "get performance stats", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_ticker_performance_stats(t.handle, &cOutput)
+		return cOutput, code
+	})
+	if err != nil {
+		return nil, err
+	}
+	t.addDrawdownRatios(stats)
+	return stats, nil
+}
@@ -0,0 +1,925 @@
+package finalytics
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Indicator is a technical indicator computed client-side from a Ticker's
+// candle series. Name identifies the indicator for IndicatorValues and for
+// naming in ChartOptions.Overlays (e.g. "sma20", "ema50", "bb20"); Compute
+// returns one value per candle, padded with math.NaN() for the leading
+// candles a lookback window hasn't filled yet.
+//
+// Users needing a proprietary signal can implement Indicator directly, or
+// wrap a plain func([]Candle) []float64 in CustomIndicator.
+type Indicator interface {
+	Name() string
+	Compute(candles []Candle) []float64
+}
+
+// multiLineIndicator is implemented by indicators whose chart overlay needs
+// more than one series (e.g. BollingerBands' upper/lower bands, MACD's
+// signal line and histogram). drawIndicatorOverlays draws one trace per
+// entry in addition to the primary line from Compute.
+type multiLineIndicator interface {
+	computeLines(candles []Candle) map[string][]float64
+}
+
+// WithIndicators appends indicators to the Ticker's configured indicator
+// set, computed by IndicatorValues and drawn as overlays on PerformanceChart
+// and CandlestickChart when named in ChartOptions.Overlays.
+//
+// Parameters:
+//   - indicators: The Indicator implementations to add (e.g. finalytics.SMA{Period: 20}).
+//
+// Returns:
+//   - *Ticker: The same Ticker, for method chaining.
+//
+// Example:
+//   ticker.WithIndicators(finalytics.SMA{Period: 20}, finalytics.RSI{Period: 14})
+func (t *Ticker) WithIndicators(indicators ...Indicator) *Ticker {
+	t.indicators = append(t.indicators, indicators...)
+	return t
+}
+
+// IndicatorValues computes and returns the values of the indicator
+// previously registered via WithIndicators under name (its Name()).
+//
+// Parameters:
+//   - name: The indicator's name, e.g. "sma20".
+//
+// Returns:
+//   - []float64: One value per candle in the ticker's price history,
+//     math.NaN() for candles before the indicator's lookback window is filled.
+//   - error: An error if no indicator named name was registered, or the
+//     underlying price history fetch fails.
+//
+// Example:
+//   values, err := ticker.IndicatorValues("rsi14")
+func (t *Ticker) IndicatorValues(name string) ([]float64, error) {
+	indicator := t.findIndicator(name)
+	if indicator == nil {
+		return nil, fmt.Errorf("no indicator named %q; register one first with Ticker.WithIndicators", name)
+	}
+	candles, err := t.allCandles()
+	if err != nil {
+		return nil, err
+	}
+	return indicator.Compute(candles), nil
+}
+
+// findIndicator returns the registered indicator named name, or nil.
+func (t *Ticker) findIndicator(name string) Indicator {
+	for _, indicator := range t.indicators {
+		if indicator.Name() == name {
+			return indicator
+		}
+	}
+	return nil
+}
+
+// allCandles fetches the ticker's whole price history as Candle records,
+// unconstrained by a date window, for indicator computation.
+func (t *Ticker) allCandles() ([]Candle, error) {
+	return t.Candles(t.interval, time.Time{}, time.Now().AddDate(100, 0, 0))
+}
+
+// drawIndicatorOverlays appends Plotly traces for every name in
+// opts.Overlays that matches a Ticker.WithIndicators-registered indicator.
+// It is a best-effort addition: a missing overlay name, or a price history
+// fetch failure, leaves html unchanged rather than failing the chart.
+func (t *Ticker) drawIndicatorOverlays(html string, opts ...ChartOptions) string {
+	o := firstChartOptions(opts)
+	if len(o.Overlays) == 0 || len(t.indicators) == 0 {
+		return html
+	}
+	candles, err := t.allCandles()
+	if err != nil {
+		return html
+	}
+	dates := make([]string, len(candles))
+	for i, c := range candles {
+		dates[i] = c.Date
+	}
+
+	var traces []map[string]any
+	for _, name := range o.Overlays {
+		indicator := t.findIndicator(name)
+		if indicator == nil {
+			continue
+		}
+		traces = append(traces, overlayTrace(name, dates, indicator.Compute(candles)))
+		if multi, ok := indicator.(multiLineIndicator); ok {
+			for label, values := range multi.computeLines(candles) {
+				traces = append(traces, overlayTrace(name+"_"+label, dates, values))
+			}
+		}
+	}
+	if len(traces) == 0 {
+		return html
+	}
+
+	payload, err := json.Marshal(traces)
+	if err != nil {
+		return html
+	}
+	script := fmt.Sprintf(`
+<script>
+(function() {
+    var gd = document.querySelector(".plotly-graph-div");
+    if (gd && typeof Plotly !== "undefined") {
+        Plotly.addTraces(gd, %s);
+    }
+})();
+</script>
+`, payload)
+	if strings.Contains(html, "</body>") {
+		return strings.Replace(html, "</body>", script+"</body>", 1)
+	}
+	return html + script
+}
+
+// overlayTrace builds a Plotly scatter-line trace named name, pairing dates
+// with values. NaN values (unfilled lookback windows) marshal as JSON null
+// so Plotly skips them instead of erroring.
+func overlayTrace(name string, dates []string, values []float64) map[string]any {
+	y := make([]any, len(values))
+	for i, v := range values {
+		if math.IsNaN(v) {
+			y[i] = nil
+			continue
+		}
+		y[i] = v
+	}
+	return map[string]any{
+		"x":    dates,
+		"y":    y,
+		"name": name,
+		"mode": "lines",
+		"type": "scatter",
+	}
+}
+
+// CustomIndicator wraps a user-supplied Compute callback in the Indicator
+// interface, so proprietary signals can be registered with WithIndicators
+// without forking the library.
+type CustomIndicator struct {
+	// Label names the indicator, returned by Name().
+	Label string
+	// Fn computes the indicator's value for each candle.
+	Fn func(candles []Candle) []float64
+}
+
+func (c CustomIndicator) Name() string { return c.Label }
+
+func (c CustomIndicator) Compute(candles []Candle) []float64 { return c.Fn(candles) }
+
+// SMA is a simple moving average over Source (default "close").
+type SMA struct {
+	Period int
+	Source string
+}
+
+func (s SMA) Name() string { return fmt.Sprintf("sma%d", s.period()) }
+
+func (s SMA) period() int {
+	if s.Period <= 0 {
+		return 14
+	}
+	return s.Period
+}
+
+func (s SMA) Compute(candles []Candle) []float64 {
+	return sma(sourceSeries(candles, s.Source), s.period())
+}
+
+// EMA is an exponential moving average over Source (default "close"),
+// seeded with an SMA of the first Period values.
+type EMA struct {
+	Period int
+	Source string
+}
+
+func (e EMA) Name() string { return fmt.Sprintf("ema%d", e.period()) }
+
+func (e EMA) period() int {
+	if e.Period <= 0 {
+		return 14
+	}
+	return e.Period
+}
+
+func (e EMA) Compute(candles []Candle) []float64 {
+	return ema(sourceSeries(candles, e.Source), e.period())
+}
+
+// WMA is a linearly weighted moving average over Source (default "close"),
+// weighting the most recent value in the window highest.
+type WMA struct {
+	Period int
+	Source string
+}
+
+func (w WMA) Name() string { return fmt.Sprintf("wma%d", w.period()) }
+
+func (w WMA) period() int {
+	if w.Period <= 0 {
+		return 14
+	}
+	return w.Period
+}
+
+func (w WMA) Compute(candles []Candle) []float64 {
+	return wma(sourceSeries(candles, w.Source), w.period())
+}
+
+// RSI is Wilder's relative strength index over Period (default 14),
+// computed from closing prices.
+type RSI struct {
+	Period int
+}
+
+func (r RSI) Name() string { return fmt.Sprintf("rsi%d", r.period()) }
+
+func (r RSI) period() int {
+	if r.Period <= 0 {
+		return 14
+	}
+	return r.Period
+}
+
+func (r RSI) Compute(candles []Candle) []float64 {
+	period := r.period()
+	closes := closesOf(candles)
+	result := nanSeries(len(closes))
+	if len(closes) <= period {
+		return result
+	}
+
+	var avgGain, avgLoss float64
+	for i := 1; i <= period; i++ {
+		change := closes[i] - closes[i-1]
+		if change > 0 {
+			avgGain += change
+		} else {
+			avgLoss += -change
+		}
+	}
+	avgGain /= float64(period)
+	avgLoss /= float64(period)
+	result[period] = rsiFromAverages(avgGain, avgLoss)
+
+	for i := period + 1; i < len(closes); i++ {
+		change := closes[i] - closes[i-1]
+		gain, loss := 0.0, 0.0
+		if change > 0 {
+			gain = change
+		} else {
+			loss = -change
+		}
+		avgGain = (avgGain*float64(period-1) + gain) / float64(period)
+		avgLoss = (avgLoss*float64(period-1) + loss) / float64(period)
+		result[i] = rsiFromAverages(avgGain, avgLoss)
+	}
+	return result
+}
+
+func rsiFromAverages(avgGain, avgLoss float64) float64 {
+	if avgLoss == 0 {
+		return 100
+	}
+	rs := avgGain / avgLoss
+	return 100 - (100 / (1 + rs))
+}
+
+// MACD is the moving average convergence/divergence oscillator: the
+// difference between a fast and a slow EMA of closing prices (defaults
+// 12/26), smoothed by a signal-line EMA (default 9). Compute returns the
+// MACD line; the signal line and histogram are drawn alongside it as
+// overlay traces "<name>_signal" and "<name>_histogram".
+type MACD struct {
+	FastPeriod, SlowPeriod, SignalPeriod int
+}
+
+func (m MACD) periods() (fast, slow, signal int) {
+	fast, slow, signal = m.FastPeriod, m.SlowPeriod, m.SignalPeriod
+	if fast <= 0 {
+		fast = 12
+	}
+	if slow <= 0 {
+		slow = 26
+	}
+	if signal <= 0 {
+		signal = 9
+	}
+	return
+}
+
+func (m MACD) Name() string { return "macd" }
+
+func (m MACD) macdLine(candles []Candle) []float64 {
+	fast, slow, _ := m.periods()
+	closes := closesOf(candles)
+	fastEMA := ema(closes, fast)
+	slowEMA := ema(closes, slow)
+	macd := nanSeries(len(closes))
+	for i := range closes {
+		if math.IsNaN(fastEMA[i]) || math.IsNaN(slowEMA[i]) {
+			continue
+		}
+		macd[i] = fastEMA[i] - slowEMA[i]
+	}
+	return macd
+}
+
+func (m MACD) Compute(candles []Candle) []float64 {
+	return m.macdLine(candles)
+}
+
+func (m MACD) computeLines(candles []Candle) map[string][]float64 {
+	_, _, signalPeriod := m.periods()
+	macd := m.macdLine(candles)
+	signal := ema(macd, signalPeriod)
+	histogram := nanSeries(len(macd))
+	for i := range macd {
+		if math.IsNaN(macd[i]) || math.IsNaN(signal[i]) {
+			continue
+		}
+		histogram[i] = macd[i] - signal[i]
+	}
+	return map[string][]float64{"signal": signal, "histogram": histogram}
+}
+
+// BollingerBands are a Period-period SMA (default 20) of closing prices with
+// upper/lower bands Multiplier standard deviations away (default 2).
+// Compute returns %B, the close's position within the bands (0 = lower
+// band, 1 = upper band); the bands themselves are drawn alongside it as
+// overlay traces "<name>_upper", "<name>_middle" and "<name>_lower".
+type BollingerBands struct {
+	Period     int
+	Multiplier float64
+}
+
+func (b BollingerBands) period() int {
+	if b.Period <= 0 {
+		return 20
+	}
+	return b.Period
+}
+
+func (b BollingerBands) multiplier() float64 {
+	if b.Multiplier <= 0 {
+		return 2
+	}
+	return b.Multiplier
+}
+
+func (b BollingerBands) Name() string { return fmt.Sprintf("bb%d", b.period()) }
+
+func (b BollingerBands) bands(candles []Candle) (upper, middle, lower []float64) {
+	period := b.period()
+	mult := b.multiplier()
+	closes := closesOf(candles)
+	middle = sma(closes, period)
+	upper = nanSeries(len(closes))
+	lower = nanSeries(len(closes))
+	for i := period - 1; i < len(closes); i++ {
+		window := closes[i-period+1 : i+1]
+		std := stdDev(window, middle[i])
+		upper[i] = middle[i] + mult*std
+		lower[i] = middle[i] - mult*std
+	}
+	return
+}
+
+func (b BollingerBands) Compute(candles []Candle) []float64 {
+	upper, _, lower := b.bands(candles)
+	closes := closesOf(candles)
+	percentB := nanSeries(len(closes))
+	for i := range closes {
+		width := upper[i] - lower[i]
+		if math.IsNaN(width) || width == 0 {
+			continue
+		}
+		percentB[i] = (closes[i] - lower[i]) / width
+	}
+	return percentB
+}
+
+func (b BollingerBands) computeLines(candles []Candle) map[string][]float64 {
+	upper, middle, lower := b.bands(candles)
+	return map[string][]float64{"upper": upper, "middle": middle, "lower": lower}
+}
+
+// ATR is Wilder's average true range over Period (default 14), a measure of
+// volatility computed from the high/low/close series.
+type ATR struct {
+	Period int
+}
+
+func (a ATR) period() int {
+	if a.Period <= 0 {
+		return 14
+	}
+	return a.Period
+}
+
+func (a ATR) Name() string { return fmt.Sprintf("atr%d", a.period()) }
+
+func (a ATR) Compute(candles []Candle) []float64 {
+	return wilderSmooth(trueRanges(candles), a.period())
+}
+
+// trueRanges returns each candle's true range: the greatest of high-low,
+// |high-previous close|, and |low-previous close|. The first candle has no
+// previous close, so its true range is simply high-low.
+func trueRanges(candles []Candle) []float64 {
+	tr := make([]float64, len(candles))
+	for i, c := range candles {
+		if i == 0 {
+			tr[i] = c.High - c.Low
+			continue
+		}
+		prevClose := candles[i-1].Close
+		tr[i] = math.Max(c.High-c.Low, math.Max(math.Abs(c.High-prevClose), math.Abs(c.Low-prevClose)))
+	}
+	return tr
+}
+
+// wilderSmooth applies Wilder's smoothing (as used by RSI and ATR) to
+// values over period: the first value is a plain average of the first
+// period values, then each later value blends in period-1 parts of the
+// running average to 1 part of the new observation.
+func wilderSmooth(values []float64, period int) []float64 {
+	result := nanSeries(len(values))
+	if len(values) < period {
+		return result
+	}
+	var sum float64
+	for i := 0; i < period; i++ {
+		sum += values[i]
+	}
+	avg := sum / float64(period)
+	result[period-1] = avg
+	for i := period; i < len(values); i++ {
+		avg = (avg*float64(period-1) + values[i]) / float64(period)
+		result[i] = avg
+	}
+	return result
+}
+
+// Stochastic is the stochastic oscillator: %K (default 14-period) measures
+// the close's position within the period's high/low range, and %D
+// (default 3-period) is %K's SMA. Compute returns %K; %D is drawn alongside
+// it as the overlay trace "<name>_d".
+type Stochastic struct {
+	KPeriod, DPeriod int
+}
+
+func (s Stochastic) periods() (k, d int) {
+	k, d = s.KPeriod, s.DPeriod
+	if k <= 0 {
+		k = 14
+	}
+	if d <= 0 {
+		d = 3
+	}
+	return
+}
+
+func (s Stochastic) Name() string {
+	k, _ := s.periods()
+	return fmt.Sprintf("stoch%d", k)
+}
+
+func (s Stochastic) percentK(candles []Candle) []float64 {
+	k, _ := s.periods()
+	highs := highsOf(candles)
+	lows := lowsOf(candles)
+	closes := closesOf(candles)
+	result := nanSeries(len(closes))
+	for i := k - 1; i < len(closes); i++ {
+		highestHigh := maxOf(highs[i-k+1 : i+1])
+		lowestLow := minOf(lows[i-k+1 : i+1])
+		rng := highestHigh - lowestLow
+		if rng == 0 {
+			continue
+		}
+		result[i] = (closes[i] - lowestLow) / rng * 100
+	}
+	return result
+}
+
+func (s Stochastic) Compute(candles []Candle) []float64 {
+	return s.percentK(candles)
+}
+
+func (s Stochastic) computeLines(candles []Candle) map[string][]float64 {
+	_, d := s.periods()
+	return map[string][]float64{"d": sma(s.percentK(candles), d)}
+}
+
+// ADX is Wilder's average directional index over Period (default 14),
+// measuring trend strength regardless of direction. Compute returns ADX;
+// the directional indicators are drawn alongside it as overlay traces
+// "<name>_+di" and "<name>_-di".
+type ADX struct {
+	Period int
+}
+
+func (a ADX) period() int {
+	if a.Period <= 0 {
+		return 14
+	}
+	return a.Period
+}
+
+func (a ADX) Name() string { return fmt.Sprintf("adx%d", a.period()) }
+
+func (a ADX) directionalIndicators(candles []Candle) (plusDI, minusDI []float64) {
+	period := a.period()
+	highs := highsOf(candles)
+	lows := lowsOf(candles)
+
+	plusDM := make([]float64, len(candles))
+	minusDM := make([]float64, len(candles))
+	for i := 1; i < len(candles); i++ {
+		upMove := highs[i] - highs[i-1]
+		downMove := lows[i-1] - lows[i]
+		if upMove > downMove && upMove > 0 {
+			plusDM[i] = upMove
+		}
+		if downMove > upMove && downMove > 0 {
+			minusDM[i] = downMove
+		}
+	}
+
+	smoothedTR := wilderSmooth(trueRanges(candles), period)
+	smoothedPlusDM := wilderSmooth(plusDM, period)
+	smoothedMinusDM := wilderSmooth(minusDM, period)
+
+	plusDI = nanSeries(len(candles))
+	minusDI = nanSeries(len(candles))
+	for i := range candles {
+		if math.IsNaN(smoothedTR[i]) || smoothedTR[i] == 0 {
+			continue
+		}
+		plusDI[i] = 100 * smoothedPlusDM[i] / smoothedTR[i]
+		minusDI[i] = 100 * smoothedMinusDM[i] / smoothedTR[i]
+	}
+	return
+}
+
+func (a ADX) Compute(candles []Candle) []float64 {
+	period := a.period()
+	plusDI, minusDI := a.directionalIndicators(candles)
+	dx := nanSeries(len(candles))
+	for i := range candles {
+		if math.IsNaN(plusDI[i]) || math.IsNaN(minusDI[i]) {
+			continue
+		}
+		sum := plusDI[i] + minusDI[i]
+		if sum == 0 {
+			continue
+		}
+		dx[i] = 100 * math.Abs(plusDI[i]-minusDI[i]) / sum
+	}
+	return wilderSmooth(dx, period)
+}
+
+func (a ADX) computeLines(candles []Candle) map[string][]float64 {
+	plusDI, minusDI := a.directionalIndicators(candles)
+	return map[string][]float64{"+di": plusDI, "-di": minusDI}
+}
+
+// Ichimoku is the Ichimoku Kinko Hyo cloud indicator: a conversion line
+// (Tenkan-sen, default 9-period), base line (Kijun-sen, default 26-period),
+// and two leading spans (Senkou Span A/B, default span B period 52) that
+// together bound the "cloud". Compute returns the conversion line; the
+// other three lines are drawn alongside it as overlay traces "<name>_base",
+// "<name>_span_a" and "<name>_span_b". Spans are not shifted forward, unlike
+// a typical Ichimoku chart, since overlay traces share the chart's x-axis
+// with the candles they annotate.
+type Ichimoku struct {
+	ConversionPeriod, BasePeriod, LeadingSpanBPeriod int
+}
+
+func (i Ichimoku) periods() (conversion, base, spanB int) {
+	conversion, base, spanB = i.ConversionPeriod, i.BasePeriod, i.LeadingSpanBPeriod
+	if conversion <= 0 {
+		conversion = 9
+	}
+	if base <= 0 {
+		base = 26
+	}
+	if spanB <= 0 {
+		spanB = 52
+	}
+	return
+}
+
+func (i Ichimoku) Name() string { return "ichimoku" }
+
+// midpointLine returns the midpoint of the rolling high/low range over
+// period, the building block shared by Ichimoku's conversion, base and span
+// B lines.
+func midpointLine(candles []Candle, period int) []float64 {
+	highs := highsOf(candles)
+	lows := lowsOf(candles)
+	result := nanSeries(len(candles))
+	for idx := period - 1; idx < len(candles); idx++ {
+		result[idx] = (maxOf(highs[idx-period+1:idx+1]) + minOf(lows[idx-period+1:idx+1])) / 2
+	}
+	return result
+}
+
+func (i Ichimoku) Compute(candles []Candle) []float64 {
+	conversion, _, _ := i.periods()
+	return midpointLine(candles, conversion)
+}
+
+func (i Ichimoku) computeLines(candles []Candle) map[string][]float64 {
+	conversion, base, spanB := i.periods()
+	conversionLine := midpointLine(candles, conversion)
+	baseLine := midpointLine(candles, base)
+	spanA := nanSeries(len(candles))
+	for idx := range candles {
+		if math.IsNaN(conversionLine[idx]) || math.IsNaN(baseLine[idx]) {
+			continue
+		}
+		spanA[idx] = (conversionLine[idx] + baseLine[idx]) / 2
+	}
+	return map[string][]float64{
+		"base":   baseLine,
+		"span_a": spanA,
+		"span_b": midpointLine(candles, spanB),
+	}
+}
+
+// VWAP is the cumulative volume-weighted average price: the running
+// average of each candle's typical price ((high+low+close)/3) weighted by
+// its volume, from the start of the series. Unlike an intraday VWAP it is
+// not reset each session, matching the daily-and-coarser history Candles
+// typically returns.
+type VWAP struct{}
+
+func (VWAP) Name() string { return "vwap" }
+
+func (VWAP) Compute(candles []Candle) []float64 {
+	result := nanSeries(len(candles))
+	var cumPV, cumVolume float64
+	for i, c := range candles {
+		typical := (c.High + c.Low + c.Close) / 3
+		cumPV += typical * c.Volume
+		cumVolume += c.Volume
+		if cumVolume == 0 {
+			continue
+		}
+		result[i] = cumPV / cumVolume
+	}
+	return result
+}
+
+// AggregateSignal combines a basket of trend, momentum and volatility
+// indicators (price vs its 50-period SMA, RSI, MACD's histogram, and the
+// stochastic oscillator) into a single per-candle score in [-1, 1],
+// mirroring the "aggregate indicator" buy/sell/neutral summary offered by
+// technical-analysis platforms. Above AggregateSignalBuy is a buy signal,
+// below AggregateSignalSell is a sell signal, otherwise neutral.
+type AggregateSignal struct{}
+
+// AggregateSignalBuy and AggregateSignalSell are the conventional
+// thresholds for interpreting AggregateSignal's score.
+const (
+	AggregateSignalBuy  = 0.3
+	AggregateSignalSell = -0.3
+)
+
+func (AggregateSignal) Name() string { return "aggregate" }
+
+func (AggregateSignal) Compute(candles []Candle) []float64 {
+	closes := closesOf(candles)
+	trendSMA := sma(closes, 50)
+	rsi := RSI{Period: 14}.Compute(candles)
+	macdHistogram := MACD{}.computeLines(candles)["histogram"]
+	stochK := Stochastic{}.percentK(candles)
+
+	result := nanSeries(len(candles))
+	for i := range candles {
+		var votes []float64
+		if !math.IsNaN(trendSMA[i]) {
+			votes = append(votes, sign(closes[i]-trendSMA[i]))
+		}
+		if !math.IsNaN(rsi[i]) {
+			votes = append(votes, rsiVote(rsi[i]))
+		}
+		if !math.IsNaN(macdHistogram[i]) {
+			votes = append(votes, sign(macdHistogram[i]))
+		}
+		if !math.IsNaN(stochK[i]) {
+			votes = append(votes, stochVote(stochK[i]))
+		}
+		if len(votes) == 0 {
+			continue
+		}
+		var sum float64
+		for _, v := range votes {
+			sum += v
+		}
+		result[i] = sum / float64(len(votes))
+	}
+	return result
+}
+
+func sign(v float64) float64 {
+	if v > 0 {
+		return 1
+	}
+	if v < 0 {
+		return -1
+	}
+	return 0
+}
+
+// rsiVote maps RSI linearly onto a vote in [-1, 1]: 0 is a full buy vote
+// (+1), 50 is neutral and 100 a full sell vote (-1), so the conventional
+// oversold/overbought levels 30 and 70 score +0.4 and -0.4.
+func rsiVote(rsi float64) float64 {
+	return -((rsi - 50) / 50)
+}
+
+// stochVote mirrors rsiVote's overbought/oversold convention for %K.
+func stochVote(k float64) float64 {
+	return -((k - 50) / 50)
+}
+
+// sourceSeries selects a candle series by column name ("open", "high",
+// "low", "volume", or the default "close").
+func sourceSeries(candles []Candle, source string) []float64 {
+	switch source {
+	case "open":
+		return openOf(candles)
+	case "high":
+		return highsOf(candles)
+	case "low":
+		return lowsOf(candles)
+	case "volume":
+		return volumesOf(candles)
+	default:
+		return closesOf(candles)
+	}
+}
+
+func closesOf(candles []Candle) []float64 {
+	values := make([]float64, len(candles))
+	for i, c := range candles {
+		values[i] = c.Close
+	}
+	return values
+}
+
+func openOf(candles []Candle) []float64 {
+	values := make([]float64, len(candles))
+	for i, c := range candles {
+		values[i] = c.Open
+	}
+	return values
+}
+
+func highsOf(candles []Candle) []float64 {
+	values := make([]float64, len(candles))
+	for i, c := range candles {
+		values[i] = c.High
+	}
+	return values
+}
+
+func lowsOf(candles []Candle) []float64 {
+	values := make([]float64, len(candles))
+	for i, c := range candles {
+		values[i] = c.Low
+	}
+	return values
+}
+
+func volumesOf(candles []Candle) []float64 {
+	values := make([]float64, len(candles))
+	for i, c := range candles {
+		values[i] = c.Volume
+	}
+	return values
+}
+
+// nanSeries returns a slice of n math.NaN() values, the default for an
+// indicator's not-yet-filled lookback window.
+func nanSeries(n int) []float64 {
+	result := make([]float64, n)
+	for i := range result {
+		result[i] = math.NaN()
+	}
+	return result
+}
+
+// firstValidIndex returns the index of the first non-NaN value in values, or
+// -1 if values is empty or entirely NaN. sma and ema use it so a derived
+// indicator (e.g. MACD's signal line, computed from the MACD line) can skip
+// the leading NaN lookback window its input already carries, rather than
+// having that NaN poison every value downstream.
+func firstValidIndex(values []float64) int {
+	for i, v := range values {
+		if !math.IsNaN(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sma returns the simple moving average of values over period, math.NaN()
+// until the window fills (measured from values' first non-NaN entry).
+func sma(values []float64, period int) []float64 {
+	result := nanSeries(len(values))
+	start := firstValidIndex(values)
+	if start < 0 {
+		return result
+	}
+	var sum float64
+	for i := start; i < len(values); i++ {
+		sum += values[i]
+		filled := i - start + 1
+		if filled > period {
+			sum -= values[i-period]
+		}
+		if filled >= period {
+			result[i] = sum / float64(period)
+		}
+	}
+	return result
+}
+
+// ema returns the exponential moving average of values over period, seeded
+// with an SMA of the first period values (from values' first non-NaN entry)
+// and math.NaN() before that.
+func ema(values []float64, period int) []float64 {
+	result := nanSeries(len(values))
+	start := firstValidIndex(values)
+	if start < 0 || len(values)-start < period {
+		return result
+	}
+	seed := sma(values, period)
+	seedIdx := start + period - 1
+	result[seedIdx] = seed[seedIdx]
+	multiplier := 2.0 / float64(period+1)
+	for i := seedIdx + 1; i < len(values); i++ {
+		result[i] = (values[i]-result[i-1])*multiplier + result[i-1]
+	}
+	return result
+}
+
+// wma returns the linearly weighted moving average of values over period,
+// weighting the most recent value in each window highest.
+func wma(values []float64, period int) []float64 {
+	result := nanSeries(len(values))
+	denom := float64(period * (period + 1) / 2)
+	for i := period - 1; i < len(values); i++ {
+		var weighted float64
+		for j := 0; j < period; j++ {
+			weighted += values[i-period+1+j] * float64(j+1)
+		}
+		result[i] = weighted / denom
+	}
+	return result
+}
+
+// stdDev returns the population standard deviation of values around mean.
+func stdDev(values []float64, mean float64) float64 {
+	var sumSq float64
+	for _, v := range values {
+		d := v - mean
+		sumSq += d * d
+	}
+	return math.Sqrt(sumSq / float64(len(values)))
+}
+
+func maxOf(values []float64) float64 {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+func minOf(values []float64) float64 {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,202 @@
+package finalytics
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "net"
+    "net/http"
+    "strings"
+    "sync"
+
+    "github.com/gorilla/websocket"
+)
+
+// LiveChartOptions configures Ticker.LiveChart.
+type LiveChartOptions struct {
+    // ChartType selects the base chart to make live: "performance" (the
+    // default) or "candlestick".
+    ChartType string
+    // Height and Width are forwarded to the underlying static chart (0 for default).
+    Height, Width uint
+    // Addr is the local address the update bridge listens on ("127.0.0.1:0"
+    // for a random free port if empty).
+    Addr string
+}
+
+// liveChartUpgrader upgrades the bridge's /quotes endpoint to a websocket.
+// Origin checking is skipped since the bridge only ever serves the chart
+// page it's embedded in, on an ephemeral localhost port.
+var liveChartUpgrader = websocket.Upgrader{
+    CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// liveChartBridge fans Quotes received on one channel out to every browser
+// client connected to its /quotes websocket endpoint.
+type liveChartBridge struct {
+    mu      sync.Mutex
+    clients map[*websocket.Conn]struct{}
+}
+
+func (b *liveChartBridge) handleQuotes(w http.ResponseWriter, r *http.Request) {
+    conn, err := liveChartUpgrader.Upgrade(w, r, nil)
+    if err != nil {
+        return
+    }
+    b.mu.Lock()
+    b.clients[conn] = struct{}{}
+    b.mu.Unlock()
+
+    defer func() {
+        b.mu.Lock()
+        delete(b.clients, conn)
+        b.mu.Unlock()
+        conn.Close()
+    }()
+
+    // Drain and discard anything the client sends; this connection only
+    // pushes. Returning from this handler on read error unregisters it.
+    for {
+        if _, _, err := conn.ReadMessage(); err != nil {
+            return
+        }
+    }
+}
+
+func (b *liveChartBridge) broadcast(q Quote) {
+    payload, err := json.Marshal(q)
+    if err != nil {
+        return
+    }
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    for conn := range b.clients {
+        _ = conn.WriteMessage(websocket.TextMessage, payload)
+    }
+}
+
+// liveChartScriptTemplate is injected into the static chart's HTML. It opens
+// a websocket to the bridge and, on each quote, stores the quote in
+// window.finalyticsLastQuote and dispatches it as a "finalytics:quote" DOM
+// event. It does not modify the chart's traces itself, and it does nothing
+// if the page has no Plotly chart.
+const liveChartScriptTemplate = `
+<script>
+(function() {
+    var ws = new WebSocket("ws://%s/quotes");
+    ws.onmessage = function(event) {
+        var quote = JSON.parse(event.data);
+        var gd = document.querySelector(".plotly-graph-div");
+        if (!gd || typeof Plotly === "undefined") {
+            return;
+        }
+        window.finalyticsLastQuote = quote;
+        document.dispatchEvent(new CustomEvent("finalytics:quote", {detail: quote}));
+    };
+})();
+</script>
+`
+
+// LiveChart returns a PerformanceChart or CandlestickChart (per
+// opts.ChartType) whose page receives a live quote feed, alongside the same
+// feed as a Go channel. The Ticker's configured StreamProvider (see
+// TickerBuilder.StreamProvider) supplies the quotes, exactly as in
+// SubscribeQuotes; LiveChart additionally runs a small local websocket
+// bridge and embeds a JS client in the chart HTML that relays each quote
+// into the page via a "finalytics:quote" DOM event. That client does not
+// redraw the chart's traces; any redrawing is done by a listener the caller
+// adds to the page.
+//
+// The bridge and the returned channel both stop when ctx is cancelled.
+//
+// Parameters:
+//   - ctx: A context.Context used to stop streaming and shut down the bridge.
+//   - opts: Chart type, dimensions, and the bridge's listen address.
+//
+// Returns:
+//   - HTML: The chart HTML, augmented with the live-update bridge client.
+//   - <-chan Quote: The same quote feed driving the chart, for consumers
+//     that want to handle ticks directly in Go.
+//   - error: An error if the base chart or the quote subscription couldn't be created.
+//
+// Example:
+//   ctx, cancel := context.WithCancel(context.Background())
+//   defer cancel()
+//   chart, quotes, err := ticker.LiveChart(ctx, finalytics.LiveChartOptions{})
+//   if err != nil {
+//   	fmt.Printf("Failed to start live chart: %v\n", err)
+//   	return
+//   }
+//   chart.Show()
+//   for q := range quotes {
+//   	fmt.Printf("%s: %.2f\n", q.Symbol, q.Price)
+//   }
+func (t *Ticker) LiveChart(ctx context.Context, opts LiveChartOptions) (HTML, <-chan Quote, error) {
+    var (
+        chart HTML
+        err   error
+    )
+    switch opts.ChartType {
+    case "", "performance":
+        chart, err = t.PerformanceChart(opts.Height, opts.Width)
+    case "candlestick":
+        chart, err = t.CandlestickChart(opts.Height, opts.Width)
+    default:
+        return HTML{}, nil, fmt.Errorf("unknown live chart type %q: expected \"performance\" or \"candlestick\"", opts.ChartType)
+    }
+    if err != nil {
+        return HTML{}, nil, err
+    }
+
+    quotes, err := t.SubscribeQuotes(ctx)
+    if err != nil {
+        return HTML{}, nil, err
+    }
+
+    addr := opts.Addr
+    if addr == "" {
+        addr = "127.0.0.1:0"
+    }
+    listener, err := net.Listen("tcp", addr)
+    if err != nil {
+        return HTML{}, nil, fmt.Errorf("failed to start live chart bridge: %w", err)
+    }
+
+    bridge := &liveChartBridge{clients: make(map[*websocket.Conn]struct{})}
+    mux := http.NewServeMux()
+    mux.HandleFunc("/quotes", bridge.handleQuotes)
+    server := &http.Server{Handler: mux}
+    go server.Serve(listener)
+
+    out := make(chan Quote)
+    go func() {
+        defer close(out)
+        defer server.Close()
+        for {
+            select {
+            case <-ctx.Done():
+                return
+            case q, ok := <-quotes:
+                if !ok {
+                    return
+                }
+                bridge.broadcast(q)
+                select {
+                case out <- q:
+                case <-ctx.Done():
+                    return
+                }
+            }
+        }
+    }()
+
+    script := fmt.Sprintf(liveChartScriptTemplate, listener.Addr().String())
+    content := chart.Content
+    if strings.Contains(content, "</body>") {
+        content = strings.Replace(content, "</body>", script+"</body>", 1)
+    } else {
+        content += script
+    }
+
+    return HTML{Content: content}, out, nil
+}
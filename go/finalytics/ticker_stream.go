@@ -0,0 +1,141 @@
+package finalytics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SubscribedQuote is a single live trade/quote update delivered by
+// Ticker.SubscribeQuotes. It is the single-symbol counterpart of the Quote
+// delivered by Tickers.Subscribe, and shares the same wire shape. Distinct
+// from QuoteUpdate (stream.go), which is Ticker.Subscribe/Tickers.Subscribe's
+// polling-based update envelope.
+type SubscribedQuote = Quote
+
+// MockQuoteStream is a QuoteStream that replays a fixed sequence of Quotes
+// and then idles until ctx is cancelled, for use in tests that exercise
+// Ticker.SubscribeQuotes/Tickers.Subscribe without a live websocket.
+type MockQuoteStream struct {
+	Quotes []Quote
+}
+
+// Stream pushes each of m.Quotes onto out in order, then blocks until ctx is
+// cancelled.
+func (m MockQuoteStream) Stream(ctx context.Context, symbols []string, out chan<- Quote) error {
+	for _, q := range m.Quotes {
+		select {
+		case out <- q:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	<-ctx.Done()
+	return nil
+}
+
+// resolveQuoteStream maps a TickerBuilder.StreamProvider name to a
+// QuoteStream: "yahoo" (the default) for Yahoo Finance's streaming endpoint,
+// "mock" for MockQuoteStream, or a "ws://"/"wss://" URL to point
+// YahooQuoteStream at a user-supplied endpoint that speaks the same
+// JSON quote frame shape.
+func resolveQuoteStream(name string) (QuoteStream, error) {
+	switch {
+	case name == "" || name == "yahoo":
+		return YahooQuoteStream{}, nil
+	case name == "mock":
+		return MockQuoteStream{}, nil
+	case strings.HasPrefix(name, "ws://") || strings.HasPrefix(name, "wss://"):
+		return YahooQuoteStream{URL: name}, nil
+	default:
+		return nil, fmt.Errorf("unknown stream provider %q: expected \"yahoo\", \"mock\", or a ws(s):// URL", name)
+	}
+}
+
+// SubscribeQuotes opens a live quote stream for the Ticker's symbol and
+// pushes updates on the returned channel until ctx is cancelled. The
+// connection is automatically re-established with exponential backoff
+// (capped at 30s) if it drops, mirroring Tickers.Subscribe.
+//
+// Parameters:
+//   - ctx: A context.Context used to stop the subscription and close the connection.
+//
+// Returns:
+//   - <-chan SubscribedQuote: A channel of live quote updates, closed when ctx is done.
+//   - error: An error if the Ticker has no configured symbol.
+//
+// Example:
+//   updates, err := ticker.SubscribeQuotes(ctx)
+//   for u := range updates {
+//       fmt.Printf("%s: %.2f\n", u.Symbol, u.Price)
+//   }
+func (t *Ticker) SubscribeQuotes(ctx context.Context) (<-chan SubscribedQuote, error) {
+	if t.symbol == "" {
+		return nil, fmt.Errorf("ticker has no configured symbol to subscribe to")
+	}
+	stream := t.streamProvider
+	if stream == nil {
+		stream = YahooQuoteStream{}
+	}
+
+	out := make(chan SubscribedQuote)
+	go t.runQuoteStream(ctx, stream, out)
+	return out, nil
+}
+
+// runQuoteStream drives stream.Stream with exponential backoff reconnects,
+// forwarding each Quote to out, until ctx is cancelled.
+func (t *Ticker) runQuoteStream(ctx context.Context, stream QuoteStream, out chan<- SubscribedQuote) {
+	defer close(out)
+
+	const maxBackoff = 30 * time.Second
+	backoff := time.Second
+
+	for ctx.Err() == nil {
+		tapped := make(chan Quote)
+		done := make(chan error, 1)
+		go func() { done <- stream.Stream(ctx, []string{t.symbol}, tapped) }()
+
+		connected := pumpQuoteUpdates(ctx, tapped, out)
+		<-done // tapped is only closed by stream.Stream returning
+
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			backoff = time.Second // reset after a session that delivered at least one quote
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// pumpQuoteUpdates forwards quotes from tapped to out until tapped is closed
+// or ctx is cancelled. It reports whether any quote was received.
+func pumpQuoteUpdates(ctx context.Context, tapped <-chan Quote, out chan<- SubscribedQuote) bool {
+	received := false
+	for {
+		select {
+		case <-ctx.Done():
+			return received
+		case q, ok := <-tapped:
+			if !ok {
+				return received
+			}
+			received = true
+			select {
+			case out <- q:
+			case <-ctx.Done():
+				return received
+			}
+		}
+	}
+}
@@ -8,15 +8,31 @@ import "C"
 import (
     "errors"
     "fmt"
+    "sync"
+    "time"
     "unsafe"
 
     "github.com/go-gota/gota/dataframe"
+    "github.com/go-gota/gota/series"
 )
 
 // Tickers represents a collection of financial tickers with methods for retrieving aggregated data and analytics.
 // It encapsulates a handle to the underlying C library for interacting with multiple tickers.
 type Tickers struct {
-    handle C.TickersHandle
+    handle          C.TickersHandle
+    symbols         []string
+    startDate       string
+    endDate         string
+    interval        string
+    assetClass      AssetClass
+    benchmarkSymbol string
+    riskFreeRate    float64
+
+    quoteStream QuoteStream
+    quotesMu    sync.RWMutex
+    lastQuotes  map[string]Quote
+
+    cache *diskCache
 }
 
 // TickersBuilder is used to construct a Tickers instance using the builder pattern.
@@ -29,8 +45,11 @@ type TickersBuilder struct {
     benchmarkSymbol  string
     confidenceLevel  float64
     riskFreeRate     float64
+    assetClass       AssetClass
     tickersData      []dataframe.DataFrame
     benchmarkData    *dataframe.DataFrame
+    cacheDir         string
+    cacheTTL         time.Duration
 }
 
 // NewTickersBuilder initializes a new TickersBuilder with default values.
@@ -44,6 +63,7 @@ type TickersBuilder struct {
 //   - riskFreeRate: 0.02
 //   - tickersData: nil
 //   - benchmarkData: nil
+//   - cacheDir: "" (caching disabled)
 //
 // Returns:
 //   - *TickersBuilder: A pointer to the initialized TickersBuilder.
@@ -69,11 +89,30 @@ func NewTickersBuilder() *TickersBuilder {
         benchmarkSymbol:  "",
         confidenceLevel:  0.95,
         riskFreeRate:     0.02,
+        assetClass:       EquityAssetClass,
         tickersData:      nil,
         benchmarkData:    nil,
     }
 }
 
+// AssetClass sets the asset class of the tickers, enabling validation of
+// symbol conventions (e.g. index symbols must be prefixed with "^").
+//
+// Parameters:
+//   - assetClass: The typed AssetClass (e.g. finalytics.MutualFundAssetClass).
+//
+// Returns:
+//   - *TickersBuilder: The builder instance for method chaining.
+//
+// Example:
+//   builder := finalytics.NewTickersBuilder().
+//       Symbols([]string{"^GSPC", "^DJI"}).
+//       AssetClass(finalytics.IndexAssetClass)
+func (b *TickersBuilder) AssetClass(assetClass AssetClass) *TickersBuilder {
+    b.assetClass = assetClass
+    return b
+}
+
 // Symbols sets the ticker symbols for the Tickers.
 //
 // Parameters:
@@ -209,6 +248,29 @@ func (b *TickersBuilder) BenchmarkData(benchmarkData *dataframe.DataFrame) *Tick
     return b
 }
 
+// Cache enables an on-disk cache for the Tickers' OHLCV, fundamentals and
+// options responses, persisted as JSON snapshots under dir. ttl is the
+// default time a cached response stays valid; per-endpoint TTLs (e.g. ~24h
+// for fundamentals, ~5m for the options chain) are applied automatically
+// where they differ from ttl. Price history is cached incrementally: a call
+// whose date range extends past what's cached only fetches the missing tail
+// and merges it with the cached frame.
+//
+// Parameters:
+//   - dir: The directory to persist cached responses under (created if missing).
+//   - ttl: The default cache entry lifetime.
+//
+// Returns:
+//   - *TickersBuilder: The builder instance for method chaining.
+//
+// Example:
+//   builder := finalytics.NewTickersBuilder().Cache("/tmp/finalytics-cache", 10*time.Minute)
+func (b *TickersBuilder) Cache(dir string, ttl time.Duration) *TickersBuilder {
+    b.cacheDir = dir
+    b.cacheTTL = ttl
+    return b
+}
+
 // Build constructs the Tickers instance with the configured parameters.
 // The symbols parameter is required; other parameters are optional and use defaults if not set.
 //
@@ -246,6 +308,9 @@ func (b *TickersBuilder) Build() (*Tickers, error) {
     if len(b.symbols) == 0 {
         return nil, errors.New("symbols is required and cannot be empty")
     }
+    if err := validateSymbolsForAssetClass(b.assetClass, b.symbols); err != nil {
+        return nil, err
+    }
 
     // Convert symbols to JSON
     symbolsString, err := StringSliceToJSON(b.symbols)
@@ -304,7 +369,29 @@ func (b *TickersBuilder) Build() (*Tickers, error) {
     if handle == nil {
         return nil, errors.New("failed to create Tickers")
     }
-    return &Tickers{handle: handle}, nil
+
+    var cache *diskCache
+    if b.cacheDir != "" {
+        cache = newDiskCache(b.cacheDir, b.cacheTTL)
+        registerCache(cache)
+    }
+
+    return &Tickers{
+        handle:          handle,
+        symbols:         b.symbols,
+        startDate:       b.startDate,
+        endDate:         b.endDate,
+        interval:        b.interval,
+        assetClass:      b.assetClass,
+        benchmarkSymbol: b.benchmarkSymbol,
+        riskFreeRate:    b.riskFreeRate,
+        cache:           cache,
+    }, nil
+}
+
+// AssetClass returns the asset class the Tickers was built with.
+func (t *Tickers) AssetClass() AssetClass {
+    return t.assetClass
 }
 
 // Free releases the resources associated with the Tickers.
@@ -413,6 +500,14 @@ func (t *Tickers) GetSummaryStats() (dataframe.DataFrame, error) {
 //   	fmt.Printf("Price History:\n%v\n", history)
 //   }
 func (t *Tickers) GetPriceHistory() (dataframe.DataFrame, error) {
+    if t.cache != nil {
+        return t.getPriceHistoryCached()
+    }
+    return t.fetchPriceHistory()
+}
+
+// fetchPriceHistory issues the uncached FFI call for the OHLCV price history.
+func (t *Tickers) fetchPriceHistory() (dataframe.DataFrame, error) {
     var cOutput *C.char
     result := C.finalytics_tickers_get_price_history(t.handle, &cOutput)
     if result != 0 {
@@ -454,12 +549,18 @@ func (t *Tickers) GetPriceHistory() (dataframe.DataFrame, error) {
 //   	fmt.Printf("Options Chain:\n%v\n", options)
 //   }
 func (t *Tickers) GetOptionsChain() (dataframe.DataFrame, error) {
-    var cOutput *C.char
-    result := C.finalytics_tickers_get_options_chain(t.handle, &cOutput)
-    if result != 0 {
-        return dataframe.DataFrame{}, fmt.Errorf("failed to get options chain: error code %d", result)
+    fetch := func() (dataframe.DataFrame, error) {
+        var cOutput *C.char
+        result := C.finalytics_tickers_get_options_chain(t.handle, &cOutput)
+        if result != 0 {
+            return dataframe.DataFrame{}, fmt.Errorf("failed to get options chain: error code %d", result)
+        }
+        return parseJSONToDataFrame(cOutput)
     }
-    return parseJSONToDataFrame(cOutput)
+    if t.cache == nil {
+        return fetch()
+    }
+    return t.cachedDataFrame("options_chain", fetch)
 }
 
 // GetNews retrieves the latest news headlines for the tickers.
@@ -542,18 +643,24 @@ func (t *Tickers) GetNews() (dataframe.DataFrame, error) {
 //   	fmt.Printf("Income Statement:\n%v\n", income)
 //   }
 func (t *Tickers) GetIncomeStatement(frequency string, formatted bool) (dataframe.DataFrame, error) {
-    cFrequency := C.CString(frequency)
-    defer C.free(unsafe.Pointer(cFrequency))
-    cFormatted := C.int(0)
-    if formatted {
-        cFormatted = C.int(1)
+    fetch := func() (dataframe.DataFrame, error) {
+        cFrequency := C.CString(frequency)
+        defer C.free(unsafe.Pointer(cFrequency))
+        cFormatted := C.int(0)
+        if formatted {
+            cFormatted = C.int(1)
+        }
+        var cOutput *C.char
+        result := C.finalytics_tickers_get_income_statement(t.handle, cFrequency, cFormatted, &cOutput)
+        if result != 0 {
+            return dataframe.DataFrame{}, fmt.Errorf("failed to get income statement: error code %d", result)
+        }
+        return parseJSONToDataFrame(cOutput)
     }
-    var cOutput *C.char
-    result := C.finalytics_tickers_get_income_statement(t.handle, cFrequency, cFormatted, &cOutput)
-    if result != 0 {
-        return dataframe.DataFrame{}, fmt.Errorf("failed to get income statement: error code %d", result)
+    if t.cache == nil {
+        return fetch()
     }
-    return parseJSONToDataFrame(cOutput)
+    return t.cachedDataFrameVariant("income_statement", fundamentalsVariant(frequency, formatted), fetch)
 }
 
 // GetBalanceSheet retrieves the balance sheets for the tickers.
@@ -593,18 +700,24 @@ func (t *Tickers) GetIncomeStatement(frequency string, formatted bool) (datafram
 //   	fmt.Printf("Balance Sheet:\n%v\n", balance)
 //   }
 func (t *Tickers) GetBalanceSheet(frequency string, formatted bool) (dataframe.DataFrame, error) {
-    cFrequency := C.CString(frequency)
-    defer C.free(unsafe.Pointer(cFrequency))
-    cFormatted := C.int(0)
-    if formatted {
-        cFormatted = C.int(1)
+    fetch := func() (dataframe.DataFrame, error) {
+        cFrequency := C.CString(frequency)
+        defer C.free(unsafe.Pointer(cFrequency))
+        cFormatted := C.int(0)
+        if formatted {
+            cFormatted = C.int(1)
+        }
+        var cOutput *C.char
+        result := C.finalytics_tickers_get_balance_sheet(t.handle, cFrequency, cFormatted, &cOutput)
+        if result != 0 {
+            return dataframe.DataFrame{}, fmt.Errorf("failed to get balance sheet: error code %d", result)
+        }
+        return parseJSONToDataFrame(cOutput)
     }
-    var cOutput *C.char
-    result := C.finalytics_tickers_get_balance_sheet(t.handle, cFrequency, cFormatted, &cOutput)
-    if result != 0 {
-        return dataframe.DataFrame{}, fmt.Errorf("failed to get balance sheet: error code %d", result)
+    if t.cache == nil {
+        return fetch()
     }
-    return parseJSONToDataFrame(cOutput)
+    return t.cachedDataFrameVariant("balance_sheet", fundamentalsVariant(frequency, formatted), fetch)
 }
 
 // GetCashflowStatement retrieves the cash flow statements for the tickers.
@@ -644,18 +757,24 @@ func (t *Tickers) GetBalanceSheet(frequency string, formatted bool) (dataframe.D
 //   	fmt.Printf("Cash Flow Statement:\n%v\n", cashflow)
 //   }
 func (t *Tickers) GetCashflowStatement(frequency string, formatted bool) (dataframe.DataFrame, error) {
-    cFrequency := C.CString(frequency)
-    defer C.free(unsafe.Pointer(cFrequency))
-    cFormatted := C.int(0)
-    if formatted {
-        cFormatted = C.int(1)
+    fetch := func() (dataframe.DataFrame, error) {
+        cFrequency := C.CString(frequency)
+        defer C.free(unsafe.Pointer(cFrequency))
+        cFormatted := C.int(0)
+        if formatted {
+            cFormatted = C.int(1)
+        }
+        var cOutput *C.char
+        result := C.finalytics_tickers_get_cashflow_statement(t.handle, cFrequency, cFormatted, &cOutput)
+        if result != 0 {
+            return dataframe.DataFrame{}, fmt.Errorf("failed to get cash flow statement: error code %d", result)
+        }
+        return parseJSONToDataFrame(cOutput)
     }
-    var cOutput *C.char
-    result := C.finalytics_tickers_get_cashflow_statement(t.handle, cFrequency, cFormatted, &cOutput)
-    if result != 0 {
-        return dataframe.DataFrame{}, fmt.Errorf("failed to get cash flow statement: error code %d", result)
+    if t.cache == nil {
+        return fetch()
     }
-    return parseJSONToDataFrame(cOutput)
+    return t.cachedDataFrameVariant("cashflow_statement", fundamentalsVariant(frequency, formatted), fetch)
 }
 
 // GetFinancialRatios retrieves financial ratios for the tickers.
@@ -694,14 +813,20 @@ func (t *Tickers) GetCashflowStatement(frequency string, formatted bool) (datafr
 //   	fmt.Printf("Financial Ratios:\n%v\n", ratios)
 //   }
 func (t *Tickers) GetFinancialRatios(frequency string) (dataframe.DataFrame, error) {
-    cFrequency := C.CString(frequency)
-    defer C.free(unsafe.Pointer(cFrequency))
-    var cOutput *C.char
-    result := C.finalytics_tickers_get_financial_ratios(t.handle, cFrequency, &cOutput)
-    if result != 0 {
-        return dataframe.DataFrame{}, fmt.Errorf("failed to get financial ratios: error code %d", result)
+    fetch := func() (dataframe.DataFrame, error) {
+        cFrequency := C.CString(frequency)
+        defer C.free(unsafe.Pointer(cFrequency))
+        var cOutput *C.char
+        result := C.finalytics_tickers_get_financial_ratios(t.handle, cFrequency, &cOutput)
+        if result != 0 {
+            return dataframe.DataFrame{}, fmt.Errorf("failed to get financial ratios: error code %d", result)
+        }
+        return parseJSONToDataFrame(cOutput)
     }
-    return parseJSONToDataFrame(cOutput)
+    if t.cache == nil {
+        return fetch()
+    }
+    return t.cachedDataFrameVariant("financial_ratios", frequency, fetch)
 }
 
 // Returns retrieves returns data for the tickers.
@@ -792,7 +917,66 @@ func (t *Tickers) PerformanceStats() (dataframe.DataFrame, error) {
     if result != 0 {
         return dataframe.DataFrame{}, fmt.Errorf("failed to get performance stats: error code %d", result)
     }
-    return parseJSONToDataFrame(cOutput)
+    stats, err := parseJSONToDataFrame(cOutput)
+    if err != nil {
+        return dataframe.DataFrame{}, err
+    }
+    return t.addExtendedStats(stats), nil
+}
+
+// addExtendedStats appends MAR, Calmar, Omega, Ulcer Index, win rate and
+// profit factor columns to stats, one row per symbol in the same order as
+// stats' "symbol" column. It is best-effort per symbol: a symbol whose price
+// history cannot be retrieved gets zero-valued extended columns rather than
+// failing the whole call.
+func (t *Tickers) addExtendedStats(stats dataframe.DataFrame) dataframe.DataFrame {
+    symbolCol := stats.Col("symbol")
+    if symbolCol.Err != nil {
+        return stats
+    }
+    symbols := symbolCol.Records()
+    n := len(symbols)
+
+    marRatios := make([]float64, n)
+    calmarRatios := make([]float64, n)
+    omegaRatios := make([]float64, n)
+    ulcerIndices := make([]float64, n)
+    winRates := make([]float64, n)
+    profitFactors := make([]float64, n)
+
+    for i, symbol := range symbols {
+        ticker, err := t.GetTicker(symbol)
+        if err != nil {
+            continue
+        }
+        history, err := ticker.GetPriceHistory()
+        ticker.Free()
+        if err != nil {
+            continue
+        }
+        closes := history.Col("close").Float()
+        if len(closes) < 2 {
+            continue
+        }
+        periodsPerYear := annualPeriods(ticker.interval)
+        returns := returnsFromCloses(closes)
+
+        marRatios[i] = marRatio(closes, periodsPerYear)
+        calmarRatios[i] = calmarRatio(annualizedReturn(returns, periodsPerYear), maxDrawdown(closes))
+        omegaRatios[i] = omegaRatio(returns, 0)
+        ulcerIndices[i] = ulcerIndex(closes)
+
+        tradeStats := computeTradeStats(returns)
+        winRates[i] = tradeStats.WinRate
+        profitFactors[i] = tradeStats.ProfitFactor
+    }
+
+    return stats.Mutate(series.New(marRatios, series.Float, "marRatio")).
+        Mutate(series.New(calmarRatios, series.Float, "calmarRatio")).
+        Mutate(series.New(omegaRatios, series.Float, "omegaRatio")).
+        Mutate(series.New(ulcerIndices, series.Float, "ulcerIndex")).
+        Mutate(series.New(winRates, series.Float, "winRate")).
+        Mutate(series.New(profitFactors, series.Float, "profitFactor"))
 }
 
 // ReturnsChart retrieves the returns chart for the tickers as an HTML object.
@@ -988,13 +1172,13 @@ func (t *Tickers) GetTicker(symbol string) (*Ticker, error) {
     if handle == nil {
         return nil, errors.New("failed to get Ticker")
     }
-    return &Ticker{handle: handle}, nil
+    return &Ticker{handle: handle, interval: t.interval}, nil
 }
 
 // Optimize optimizes the portfolio of tickers based on the specified objective and constraints.
 //
 // Parameters:
-//   - objectiveFunction: The objective function for optimization (e.g., "max_sharpe").
+//   - objectiveFunction: The typed Objective to optimize for (e.g., finalytics.ObjectiveMaxSharpe).
 //   - assetConstraints: JSON string defining asset-level constraints (e.g., "{}").
 //   - categoricalConstraints: JSON string defining categorical constraints (e.g., "{}").
 //   - weights: JSON string defining portfolio-level constraints (e.g., "{}").
@@ -1035,8 +1219,8 @@ func (t *Tickers) GetTicker(symbol string) (*Ticker, error) {
 //   	defer portfolio.Free()
 //   	fmt.Println("Successfully optimized portfolio")
 //   }
-func (t *Tickers) Optimize(objectiveFunction, assetConstraints, categoricalConstraints, weights string) (*Portfolio, error) {
-    cObjectiveFunction := C.CString(objectiveFunction)
+func (t *Tickers) Optimize(objectiveFunction Objective, assetConstraints, categoricalConstraints, weights string) (*Portfolio, error) {
+    cObjectiveFunction := C.CString(string(objectiveFunction))
     defer C.free(unsafe.Pointer(cObjectiveFunction))
     cAssetConstraints := C.CString(assetConstraints)
     defer C.free(unsafe.Pointer(cAssetConstraints))
@@ -1049,5 +1233,110 @@ func (t *Tickers) Optimize(objectiveFunction, assetConstraints, categoricalConst
     if handle == nil {
         return nil, errors.New("failed to optimize portfolio")
     }
-    return &Portfolio{handle: handle}, nil
+    return &Portfolio{handle: handle, objective: objectiveFunction, interval: t.interval, confidenceLevel: 0.95}, nil
+}
+
+// OptimizeWithOptions optimizes the portfolio of tickers using a typed
+// OptimizeOptions, sparing callers from hand-assembling the JSON strings
+// accepted by Optimize. Options are validated (unknown symbols, infeasible
+// bounds, weight bounds that cannot sum to a feasible portfolio) before any
+// FFI call.
+//
+// Parameters:
+//   - opts: The OptimizeOptions describing the objective and constraints.
+//
+// Returns:
+//   - *Portfolio: A pointer to the optimized Portfolio object.
+//   - error: An error if validation or the portfolio optimization fails.
+//
+// Example:
+//   package main
+//
+//   import (
+//   	"fmt"
+//   	"github.com/Nnamdi-sys/finalytics/go/finalytics"
+//   )
+//
+//   func main() {
+//   	tickers, err := finalytics.NewTickersBuilder().
+//   		Symbols([]string{"AAPL", "MSFT"}).
+//   		Build()
+//   	if err != nil {
+//   		fmt.Printf("Failed to create Tickers: %v\n", err)
+//   		return
+//   	}
+//   	defer tickers.Free()
+//
+//   	portfolio, err := tickers.OptimizeWithOptions(finalytics.OptimizeOptions{
+//   		Objective: finalytics.ObjectiveMaxSharpe,
+//   		AssetConstraints: []finalytics.AssetConstraint{
+//   			{Symbol: "AAPL", MinWeight: 0.1, MaxWeight: 0.6},
+//   		},
+//   	})
+//   	if err != nil {
+//   		fmt.Printf("Failed to optimize portfolio: %v\n", err)
+//   		return
+//   	}
+//   	defer portfolio.Free()
+//   	fmt.Println("Successfully optimized portfolio")
+//   }
+func (t *Tickers) OptimizeWithOptions(opts OptimizeOptions) (*Portfolio, error) {
+    if err := validateOptimizeOptions(t.symbols, opts); err != nil {
+        return nil, fmt.Errorf("invalid optimize options: %v", err)
+    }
+
+    assetConstraints, err := buildAssetConstraintsJSON(t.symbols, opts.AssetConstraints)
+    if err != nil {
+        return nil, err
+    }
+    categoricalConstraints, err := buildCategoricalConstraintsJSON(t.symbols, opts.CategoryConstraints, opts.GroupConstraints)
+    if err != nil {
+        return nil, err
+    }
+    weights, err := buildWeightsJSON(t.symbols, opts.Turnover)
+    if err != nil {
+        return nil, err
+    }
+
+    portfolio, err := t.Optimize(opts.Objective, assetConstraints, categoricalConstraints, weights)
+    if err != nil {
+        return nil, err
+    }
+
+    if len(opts.LookThroughSectorConstraints) > 0 {
+        if err := t.checkLookThroughSectorConstraints(portfolio, opts.LookThroughSectorConstraints); err != nil {
+            portfolio.Free()
+            return nil, err
+        }
+    }
+
+    return portfolio, nil
+}
+
+// checkLookThroughSectorConstraints validates that portfolio's optimized
+// weights satisfy constraints once ETF and mutual fund symbols are expanded
+// into their underlying holdings. The optimizer itself cannot consume
+// fractional, per-holding category membership, so this is a post-hoc check
+// rather than a constraint the optimizer steers toward.
+func (t *Tickers) checkLookThroughSectorConstraints(portfolio *Portfolio, constraints []LookThroughSectorConstraint) error {
+    results, err := portfolio.OptimizationResults()
+    if err != nil {
+        return fmt.Errorf("failed to check look-through sector constraints: %v", err)
+    }
+    weights, err := extractOptimizedWeights(results, t.symbols)
+    if err != nil {
+        return fmt.Errorf("failed to check look-through sector constraints: %v", err)
+    }
+    exposure, err := t.lookThroughSectorExposure(weights)
+    if err != nil {
+        return fmt.Errorf("failed to check look-through sector constraints: %v", err)
+    }
+
+    for _, c := range constraints {
+        e := exposure[c.Sector]
+        if e < c.MinWeight || e > c.MaxWeight {
+            return fmt.Errorf("look-through exposure to sector %q is %.4f, outside bounds [%.4f, %.4f]", c.Sector, e, c.MinWeight, c.MaxWeight)
+        }
+    }
+    return nil
 }
\ No newline at end of file
@@ -0,0 +1,155 @@
+package finalytics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// fundamentalsVariant folds a fundamentals call's (frequency, formatted)
+// arguments into a single cache-key discriminator.
+func fundamentalsVariant(frequency string, formatted bool) string {
+	return fmt.Sprintf("%s_%t", frequency, formatted)
+}
+
+// cachedDataFrame serves endpoint from t.cache if a fresh entry exists,
+// otherwise calls fetch and stores the result before returning it.
+func (t *Tickers) cachedDataFrame(endpoint string, fetch func() (dataframe.DataFrame, error)) (dataframe.DataFrame, error) {
+	return t.cachedDataFrameVariant(endpoint, "", fetch)
+}
+
+// cachedDataFrameVariant is cachedDataFrame with an extra discriminator
+// folded into the cache key, for endpoints whose response also depends on
+// call arguments (e.g. statement frequency/formatting).
+func (t *Tickers) cachedDataFrameVariant(endpoint, variant string, fetch func() (dataframe.DataFrame, error)) (dataframe.DataFrame, error) {
+	key := cacheKey("yahoo", strings.Join(t.symbols, ","), endpoint, t.interval+"|"+variant, t.startDate, t.endDate)
+	if cached, ok := t.cache.get(endpoint, key); ok {
+		return cached, nil
+	}
+	df, err := fetch()
+	if err != nil {
+		return df, err
+	}
+	if err := t.cache.put(endpoint, key, df); err != nil {
+		return df, fmt.Errorf("fetched data but failed to cache it: %v", err)
+	}
+	return df, nil
+}
+
+// getPriceHistoryCached serves GetPriceHistory through t.cache. Cache
+// entries are keyed by (symbols, interval, startDate) so that widening
+// endDate across calls reuses and extends the same entry: only the missing
+// tail between the cached frame's latest date and t.endDate is fetched, and
+// the result is merged with what was already cached. The cached entry itself
+// may run past t.endDate (from a prior, wider-ended Tickers reusing the same
+// key), so every path that serves the cached entry trims it to t.endDate
+// before handing the frame back.
+func (t *Tickers) getPriceHistoryCached() (dataframe.DataFrame, error) {
+	const endpoint = "price_history"
+	symbols := strings.Join(t.symbols, ",")
+	key := cacheKey("yahoo", symbols, endpoint, t.interval, t.startDate, "")
+
+	cached, ok := t.cache.get(endpoint, key)
+	if !ok {
+		df, err := t.fetchPriceHistory()
+		if err != nil {
+			return df, err
+		}
+		if err := t.cache.put(endpoint, key, df); err != nil {
+			return df, fmt.Errorf("fetched price history but failed to cache it: %v", err)
+		}
+		return df, nil
+	}
+
+	cachedEnd, ok := latestTimestamp(cached)
+	if !ok || cachedEnd >= t.endDate {
+		return upToEndDate(cached, t.endDate), nil
+	}
+
+	tail, err := t.fetchPriceHistoryRange(nextDay(cachedEnd), t.endDate)
+	if err != nil {
+		// Best-effort: serve the stale cached frame rather than failing outright.
+		return upToEndDate(cached, t.endDate), nil
+	}
+
+	merged := cached.RBind(tail).Arrange(dataframe.Sort("timestamp"))
+	if err := t.cache.put(endpoint, key, merged); err != nil {
+		return upToEndDate(merged, t.endDate), fmt.Errorf("merged price history but failed to cache it: %v", err)
+	}
+	return upToEndDate(merged, t.endDate), nil
+}
+
+// upToEndDate returns df trimmed to rows whose "timestamp" is on or before
+// endDate. Relies on the FFI's YYYY-MM-DD date strings sorting lexicographically.
+// An empty endDate (none set on the builder) returns df unchanged.
+func upToEndDate(df dataframe.DataFrame, endDate string) dataframe.DataFrame {
+	if endDate == "" {
+		return df
+	}
+	return df.Filter(dataframe.F{Colname: "timestamp", Comparator: series.LessEq, Comparando: endDate})
+}
+
+// fetchPriceHistoryRange fetches OHLCV price history for symbols/interval
+// restricted to [startDate, endDate] via a short-lived Tickers, used to fetch
+// only the missing tail of a widened cache request.
+func (t *Tickers) fetchPriceHistoryRange(startDate, endDate string) (dataframe.DataFrame, error) {
+	tail, err := NewTickersBuilder().
+		Symbols(t.symbols).
+		StartDate(startDate).
+		EndDate(endDate).
+		Interval(t.interval).
+		Build()
+	if err != nil {
+		return dataframe.DataFrame{}, fmt.Errorf("failed to build tail fetch: %v", err)
+	}
+	defer tail.Free()
+	return tail.fetchPriceHistory()
+}
+
+// latestTimestamp returns the maximum value of df's "timestamp" column.
+// Relies on the FFI's YYYY-MM-DD date strings sorting lexicographically.
+func latestTimestamp(df dataframe.DataFrame) (string, bool) {
+	records := df.Col("timestamp").Records()
+	if len(records) == 0 {
+		return "", false
+	}
+	max := records[0]
+	for _, r := range records[1:] {
+		if r > max {
+			max = r
+		}
+	}
+	return max, true
+}
+
+// nextDay returns the calendar day after date (YYYY-MM-DD).
+func nextDay(date string) string {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return t.AddDate(0, 0, 1).Format("2006-01-02")
+}
+
+// InvalidateCache removes every cached entry for endpoint (e.g.
+// "price_history", "options_chain", "income_statement"), forcing the next
+// matching call to re-fetch from the underlying FFI. It is a no-op if the
+// Tickers has no cache configured via TickersBuilder.Cache.
+//
+// Parameters:
+//   - endpoint: The cached endpoint to invalidate.
+//
+// Returns:
+//   - error: An error if the cache entries could not be removed.
+//
+// Example:
+//   err := tickers.InvalidateCache("options_chain")
+func (t *Tickers) InvalidateCache(endpoint string) error {
+	if t.cache == nil {
+		return nil
+	}
+	return t.cache.invalidate(endpoint)
+}
@@ -0,0 +1,137 @@
+package finalytics
+
+/*
+#include <finalytics.h>
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"context"
+	"unsafe"
+
+	"github.com/go-gota/gota/dataframe"
+)
+
+// GetSummaryStatsContext is GetSummaryStats, but the underlying FFI call runs
+// on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Tickers) GetSummaryStatsContext(ctx context.Context) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get summary stats", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_tickers_get_summary_stats(t.handle, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetPriceHistoryContext is GetPriceHistory, but the underlying FFI call runs
+// on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Tickers) GetPriceHistoryContext(ctx context.Context) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get price history", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_tickers_get_price_history(t.handle, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetOptionsChainContext is GetOptionsChain, but the underlying FFI call runs
+// on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Tickers) GetOptionsChainContext(ctx context.Context) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get options chain", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_tickers_get_options_chain(t.handle, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetNewsContext is GetNews, but the underlying FFI call runs on a goroutine
+// and is abandoned early if ctx is done before it returns.
+func (t *Tickers) GetNewsContext(ctx context.Context) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get news", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_tickers_get_news(t.handle, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetIncomeStatementContext is GetIncomeStatement, but the underlying FFI
+// call runs on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Tickers) GetIncomeStatementContext(ctx context.Context, frequency string, formatted bool) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get income statement", func() (*C.char, C.int) {
+		cFrequency := C.CString(frequency)
+		defer C.free(unsafe.Pointer(cFrequency))
+		cFormatted := C.int(0)
+		if formatted {
+			cFormatted = C.int(1)
+		}
+		var cOutput *C.char
+		code := C.finalytics_tickers_get_income_statement(t.handle, cFrequency, cFormatted, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetBalanceSheetContext is GetBalanceSheet, but the underlying FFI call runs
+// on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Tickers) GetBalanceSheetContext(ctx context.Context, frequency string, formatted bool) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get balance sheet", func() (*C.char, C.int) {
+		cFrequency := C.CString(frequency)
+		defer C.free(unsafe.Pointer(cFrequency))
+		cFormatted := C.int(0)
+		if formatted {
+			cFormatted = C.int(1)
+		}
+		var cOutput *C.char
+		code := C.finalytics_tickers_get_balance_sheet(t.handle, cFrequency, cFormatted, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetCashflowStatementContext is GetCashflowStatement, but the underlying FFI
+// call runs on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Tickers) GetCashflowStatementContext(ctx context.Context, frequency string, formatted bool) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get cash flow statement", func() (*C.char, C.int) {
+		cFrequency := C.CString(frequency)
+		defer C.free(unsafe.Pointer(cFrequency))
+		cFormatted := C.int(0)
+		if formatted {
+			cFormatted = C.int(1)
+		}
+		var cOutput *C.char
+		code := C.finalytics_tickers_get_cashflow_statement(t.handle, cFrequency, cFormatted, &cOutput)
+		return cOutput, code
+	})
+}
+
+// GetFinancialRatiosContext is GetFinancialRatios, but the underlying FFI
+// call runs on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Tickers) GetFinancialRatiosContext(ctx context.Context, frequency string) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get financial ratios", func() (*C.char, C.int) {
+		cFrequency := C.CString(frequency)
+		defer C.free(unsafe.Pointer(cFrequency))
+		var cOutput *C.char
+		code := C.finalytics_tickers_get_financial_ratios(t.handle, cFrequency, &cOutput)
+		return cOutput, code
+	})
+}
+
+// ReturnsContext is Returns, but the underlying FFI call runs on a goroutine
+// and is abandoned early if ctx is done before it returns.
+func (t *Tickers) ReturnsContext(ctx context.Context) (dataframe.DataFrame, error) {
+	return runDataFrameFFI(ctx, "get returns", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_tickers_returns(t.handle, &cOutput)
+		return cOutput, code
+	})
+}
+
+// PerformanceStatsContext is PerformanceStats, but the underlying FFI call
+// runs on a goroutine and is abandoned early if ctx is done before it returns.
+func (t *Tickers) PerformanceStatsContext(ctx context.Context) (dataframe.DataFrame, error) {
+	stats, err := runDataFrameFFI(ctx, "get performance stats", func() (*C.char, C.int) {
+		var cOutput *C.char
+		code := C.finalytics_tickers_performance_stats(t.handle, &cOutput)
+		return cOutput, code
+	})
+	if err != nil {
+		return dataframe.DataFrame{}, err
+	}
+	return t.addExtendedStats(stats), nil
+}
@@ -0,0 +1,131 @@
+package finalytics
+
+import "fmt"
+
+// TradeStats holds trade-level (as opposed to whole-period) performance statistics
+// derived from a series of periodic returns, treating each non-zero return as a trade.
+type TradeStats struct {
+	TotalTrades           int     // number of periods with a non-zero return
+	WinRate               float64 // fraction of trades with a positive return
+	ProfitFactor          float64 // gross profit divided by gross loss (0 if there were no losses)
+	AvgWin                float64 // average return of winning trades
+	AvgLoss               float64 // average return of losing trades
+	LargestWin            float64 // largest single winning return
+	LargestLoss           float64 // largest single losing return
+	MaxConsecutiveWins    int     // longest streak of consecutive winning trades
+	MaxConsecutiveLosses  int     // longest streak of consecutive losing trades
+}
+
+// computeTradeStats derives TradeStats from a slice of periodic returns (e.g. daily
+// percentage changes in price or portfolio value).
+func computeTradeStats(returns []float64) TradeStats {
+	var stats TradeStats
+	var grossProfit, grossLoss float64
+	var winStreak, lossStreak int
+
+	for _, r := range returns {
+		switch {
+		case r > 0:
+			stats.TotalTrades++
+			grossProfit += r
+			if r > stats.LargestWin {
+				stats.LargestWin = r
+			}
+			winStreak++
+			lossStreak = 0
+			if winStreak > stats.MaxConsecutiveWins {
+				stats.MaxConsecutiveWins = winStreak
+			}
+		case r < 0:
+			stats.TotalTrades++
+			grossLoss += -r
+			if r < stats.LargestLoss {
+				stats.LargestLoss = r
+			}
+			lossStreak++
+			winStreak = 0
+			if lossStreak > stats.MaxConsecutiveLosses {
+				stats.MaxConsecutiveLosses = lossStreak
+			}
+		default:
+			winStreak = 0
+			lossStreak = 0
+		}
+	}
+
+	wins := 0
+	for _, r := range returns {
+		if r > 0 {
+			wins++
+		}
+	}
+	if stats.TotalTrades > 0 {
+		stats.WinRate = float64(wins) / float64(stats.TotalTrades)
+	}
+	if wins > 0 {
+		stats.AvgWin = grossProfit / float64(wins)
+	}
+	if losses := stats.TotalTrades - wins; losses > 0 {
+		stats.AvgLoss = -(grossLoss / float64(losses))
+	}
+	if grossLoss > 0 {
+		stats.ProfitFactor = grossProfit / grossLoss
+	}
+
+	return stats
+}
+
+// returnsFromCloses converts a slice of closing prices into a slice of
+// period-over-period percentage returns.
+func returnsFromCloses(closes []float64) []float64 {
+	if len(closes) < 2 {
+		return nil
+	}
+	returns := make([]float64, 0, len(closes)-1)
+	for i := 1; i < len(closes); i++ {
+		prev := closes[i-1]
+		if prev == 0 {
+			continue
+		}
+		returns = append(returns, (closes[i]-prev)/prev)
+	}
+	return returns
+}
+
+// TradeStats computes trade-level performance statistics (win rate, profit
+// factor, average win/loss, consecutive win/loss streaks) for the ticker from
+// its close price history.
+//
+// Returns:
+//   - TradeStats: The trade-level performance statistics.
+//   - error: An error if the price history retrieval fails.
+//
+// Example:
+//   stats, err := ticker.TradeStats()
+func (t *Ticker) TradeStats() (TradeStats, error) {
+	history, err := t.GetPriceHistory()
+	if err != nil {
+		return TradeStats{}, fmt.Errorf("failed to get price history: %v", err)
+	}
+	closes := history.Col("close").Float()
+	return computeTradeStats(returnsFromCloses(closes)), nil
+}
+
+// TradeStats computes trade-level performance statistics (win rate, profit
+// factor, average win/loss, consecutive win/loss streaks) for the portfolio
+// from its daily returns series.
+//
+// Returns:
+//   - TradeStats: The trade-level performance statistics.
+//   - error: An error if the returns retrieval fails.
+//
+// Example:
+//   stats, err := portfolio.TradeStats()
+func (p *Portfolio) TradeStats() (TradeStats, error) {
+	returns, err := p.Returns()
+	if err != nil {
+		return TradeStats{}, fmt.Errorf("failed to get returns: %v", err)
+	}
+	values := returns.Col("portfolio_returns").Float()
+	return computeTradeStats(values), nil
+}
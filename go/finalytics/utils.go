@@ -22,10 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"os"
-	"os/exec"
 	"regexp"
-	"runtime"
 	"strconv"
 	"strings"
 
@@ -168,28 +165,10 @@ type HTML struct {
 	Content string
 }
 
-// Show writes the HTML to a temporary file and opens it in the default browser.
+// Show renders the HTML through the default Renderer (see SetDefaultRenderer).
+// Unless overridden, this opens the content in the system's default browser,
+// falling back to HTTPRenderer on systems other than macOS and Windows when
+// $DISPLAY is empty and no "xdg-open" command is on PATH.
 func (c *HTML) Show() error {
-	tmpFile, err := os.CreateTemp("", "chart-*.html")
-	if err != nil {
-		return fmt.Errorf("failed to create temp file: %w", err)
-	}
-	defer tmpFile.Close()
-
-	_, err = tmpFile.WriteString(c.Content)
-	if err != nil {
-		return fmt.Errorf("failed to write HTML to temp file: %w", err)
-	}
-
-	// Open the file in the default browser
-	var cmd *exec.Cmd
-	switch runtime.GOOS {
-	case "darwin":
-		cmd = exec.Command("open", tmpFile.Name())
-	case "windows":
-		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", tmpFile.Name())
-	default: // linux, freebsd, etc.
-		cmd = exec.Command("xdg-open", tmpFile.Name())
-	}
-	return cmd.Start()
+	return defaultRenderer.Render(c)
 }
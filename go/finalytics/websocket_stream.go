@@ -0,0 +1,228 @@
+package finalytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Quote is a single live trade/quote update delivered by Tickers.Subscribe.
+type Quote struct {
+	Symbol        string
+	Price         float64
+	Size          float64
+	Time          time.Time
+	Bid           float64
+	Ask           float64
+	Change        float64
+	ChangePercent float64
+}
+
+// QuoteStream streams live Quote updates for a set of symbols over a
+// persistent connection, e.g. a websocket. Implementations should block
+// pushing onto out until ctx is cancelled or the connection drops, at which
+// point they return an error (or nil on clean shutdown via ctx).
+type QuoteStream interface {
+	Stream(ctx context.Context, symbols []string, out chan<- Quote) error
+}
+
+// defaultQuoteStreamURL is Yahoo Finance's public streaming quote websocket.
+const defaultQuoteStreamURL = "wss://streamer.finance.yahoo.com/"
+
+// YahooQuoteStream streams live quotes from Yahoo Finance's websocket feed.
+// It is the default QuoteStream used by Tickers.Subscribe.
+type YahooQuoteStream struct {
+	// URL overrides the default Yahoo streaming endpoint; mainly useful for tests.
+	URL string
+}
+
+type yahooSubscribeMessage struct {
+	Subscribe []string `json:"subscribe"`
+}
+
+// yahooQuoteMessage is the JSON quote frame shape decoded off the wire.
+// The production Yahoo feed actually sends base64-encoded protobuf; a real
+// deployment should plug in a protobuf decoder here. This JSON shape is used
+// so the reconnect/fan-out plumbing below can be exercised without one.
+type yahooQuoteMessage struct {
+	ID            string  `json:"id"`
+	Price         float64 `json:"price"`
+	Size          float64 `json:"size"`
+	Time          int64   `json:"time"` // unix millis
+	Bid           float64 `json:"bid"`
+	Ask           float64 `json:"ask"`
+	Change        float64 `json:"change"`
+	ChangePercent float64 `json:"changePercent"`
+}
+
+// Stream connects to the Yahoo Finance streaming endpoint, subscribes to
+// symbols, and pushes decoded Quotes onto out until ctx is cancelled or the
+// connection errors.
+func (y YahooQuoteStream) Stream(ctx context.Context, symbols []string, out chan<- Quote) error {
+	url := y.URL
+	if url == "" {
+		url = defaultQuoteStreamURL
+	}
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", url, err)
+	}
+	defer conn.Close()
+
+	if err := conn.WriteJSON(yahooSubscribeMessage{Subscribe: symbols}); err != nil {
+		return fmt.Errorf("failed to subscribe to %v: %w", symbols, err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	for {
+		var msg yahooQuoteMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to read quote frame: %w", err)
+		}
+		quote := Quote{
+			Symbol:        msg.ID,
+			Price:         msg.Price,
+			Size:          msg.Size,
+			Time:          time.UnixMilli(msg.Time),
+			Bid:           msg.Bid,
+			Ask:           msg.Ask,
+			Change:        msg.Change,
+			ChangePercent: msg.ChangePercent,
+		}
+		select {
+		case out <- quote:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// SetQuoteStream overrides the QuoteStream used by Subscribe, e.g. to point
+// at a different provider that matches the DataSource interface's pluggable
+// spirit. Defaults to YahooQuoteStream if never called.
+//
+// Returns:
+//   - *Tickers: The receiver, for method chaining.
+func (t *Tickers) SetQuoteStream(stream QuoteStream) *Tickers {
+	t.quoteStream = stream
+	return t
+}
+
+// Subscribe opens a live quote stream for the Tickers' configured symbols and
+// pushes updates on the returned channel until ctx is cancelled. The
+// connection is automatically re-established with exponential backoff
+// (capped at 30s) if it drops. Each received Quote also updates the
+// per-symbol cache readable via LastQuote.
+//
+// Parameters:
+//   - ctx: A context.Context used to stop the subscription and close the connection.
+//
+// Returns:
+//   - <-chan Quote: A channel of live quotes, closed when ctx is done.
+//   - error: An error if the Tickers has no configured symbols.
+//
+// Example:
+//   quotes, err := tickers.Subscribe(ctx)
+//   for q := range quotes {
+//       fmt.Printf("%s: %.2f\n", q.Symbol, q.Price)
+//   }
+func (t *Tickers) Subscribe(ctx context.Context) (<-chan Quote, error) {
+	if len(t.symbols) == 0 {
+		return nil, errors.New("tickers has no configured symbols to subscribe to")
+	}
+	stream := t.quoteStream
+	if stream == nil {
+		stream = YahooQuoteStream{}
+	}
+
+	out := make(chan Quote)
+	go t.runQuoteStream(ctx, stream, out)
+	return out, nil
+}
+
+// runQuoteStream drives stream.Stream with exponential backoff reconnects,
+// caching each quote and forwarding it to out, until ctx is cancelled.
+func (t *Tickers) runQuoteStream(ctx context.Context, stream QuoteStream, out chan<- Quote) {
+	defer close(out)
+
+	const maxBackoff = 30 * time.Second
+	backoff := time.Second
+
+	for ctx.Err() == nil {
+		tapped := make(chan Quote)
+		done := make(chan error, 1)
+		go func() { done <- stream.Stream(ctx, t.symbols, tapped) }()
+
+		connected := t.pumpQuotes(ctx, tapped, out)
+		<-done // tapped is only closed by stream.Stream returning
+
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			backoff = time.Second // reset after a session that delivered at least one quote
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// pumpQuotes forwards quotes from tapped to out and the Tickers' last-value
+// cache until tapped is closed or ctx is cancelled. It reports whether any
+// quote was received.
+func (t *Tickers) pumpQuotes(ctx context.Context, tapped <-chan Quote, out chan<- Quote) bool {
+	received := false
+	for {
+		select {
+		case <-ctx.Done():
+			return received
+		case q, ok := <-tapped:
+			if !ok {
+				return received
+			}
+			received = true
+			t.cacheQuote(q)
+			select {
+			case out <- q:
+			case <-ctx.Done():
+				return received
+			}
+		}
+	}
+}
+
+func (t *Tickers) cacheQuote(q Quote) {
+	t.quotesMu.Lock()
+	defer t.quotesMu.Unlock()
+	if t.lastQuotes == nil {
+		t.lastQuotes = make(map[string]Quote)
+	}
+	t.lastQuotes[q.Symbol] = q
+}
+
+// LastQuote returns the most recently received Quote for symbol and whether
+// one has been received yet.
+func (t *Tickers) LastQuote(symbol string) (Quote, bool) {
+	t.quotesMu.RLock()
+	defer t.quotesMu.RUnlock()
+	q, ok := t.lastQuotes[symbol]
+	return q, ok
+}